@@ -4,30 +4,70 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
 	"terraform-provider-bluecat/bluecat/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// DataSourceBlock The IPv4 Block
 func DataSourceBlock() *schema.Resource {
+	return dataSourceBlock(entities.IPV4)
+}
+
+// DataSourceIPv6Block The IPv6 Block
+func DataSourceIPv6Block() *schema.Resource {
+	return dataSourceBlock(entities.IPV6)
+}
+
+// dataSourceBlock The Block of the IP version of the data source unless ip_version or the CIDR tells otherwise
+func dataSourceBlock(ipVersion string) *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceBlockRead,
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dataSourceBlockRead(d, m, ipVersion)
+		},
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The Configuration. Getting the IPv4 Block in the default Configuration if doesn't specify",
+				Computed:    true,
+				Description: "The Configuration. Getting the Block in the default Configuration if doesn't specify",
 			},
 			"cidr": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "IPv4 Block's CIDR",
+				Description: "Block's CIDR",
 			},
 			"name": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The Block name",
 			},
+			"parent_block": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The parent Block of the Block in CIDR format, empty for a top level Block",
+			},
+			"prefix": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The prefix length of the Block",
+			},
+			"total_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of addresses of the Block, as a string since the IPv6 counts don't fit a number",
+			},
+			"allocated_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of allocated addresses of the Block",
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of the addresses of the Block which are allocated",
+			},
 			"properties": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -47,21 +87,27 @@ func DataSourceBlock() *schema.Resource {
 			"ip_version": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Block IP version: ipv4 or ipv6",
+				Computed:    true,
+				Description: fmt.Sprintf("Block IP version: ipv4 or ipv6. Detected from the CIDR, %s otherwise", ipVersion),
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if version := v.(string); version != entities.IPV4 && version != entities.IPV6 {
+						errs = append(errs, fmt.Errorf("%s must be %s or %s, got %q", k, entities.IPV4, entities.IPV6, version))
+					}
+					return
+				},
 			},
 		},
 	}
 }
 
-func dataSourceBlockRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceBlockRead(d *schema.ResourceData, m interface{}, defaultVersion string) error {
+	applyProviderDefaults(d, m, "configuration")
 
 	configuration := d.Get("configuration").(string)
 	cidr := d.Get("cidr").(string)
-	ipVersion := d.Get("ip_version").(string)
+	ipVersion := getDataSourceIPVersion(d, cidr, defaultVersion)
 
-	connector := m.(*utils.Connector)
-	objMgr := new(utils.ObjectManager)
-	objMgr.Connector = connector
+	objMgr := GetObjManager(m)
 
 	if !(strings.Contains(cidr, "/")) {
 		msg := fmt.Sprintf("Invalid cidr block %s", cidr)
@@ -93,6 +139,11 @@ func dataSourceBlockRead(d *schema.ResourceData, m interface{}) error {
 	d.SetId(strconv.Itoa(block.BlockId))
 
 	d.Set("name", block.Name)
+	d.Set("ip_version", ipVersion)
+	d.Set("parent_block", block.ParentBlock)
+	prefix, _ := strconv.Atoi(cidr)
+	d.Set("prefix", prefix)
+	setAddressUsage(d, block.Usage)
 
 	return nil
 }
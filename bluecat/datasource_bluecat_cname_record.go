@@ -15,16 +15,19 @@ func DataSourceCNAMERecord() *schema.Resource {
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Getting the CNAME record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be got under default view",
 			},
 			"zone": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Zone in which you want to get a CNAME record",
 			},
 			"canonical": {
@@ -63,6 +66,8 @@ func DataSourceCNAMERecord() *schema.Resource {
 }
 
 func dataSourceCNAMERecordRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "canonical")
 
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
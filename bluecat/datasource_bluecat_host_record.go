@@ -19,16 +19,19 @@ func DataSourceHostRecord() *schema.Resource {
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Getting the Host record in the default Configuration if doesn't specify.",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be got under default view.",
 			},
 			"zone": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Zone which contains the details of the Host record.",
 			},
 			"fqdn": {
@@ -41,6 +44,12 @@ func DataSourceHostRecord() *schema.Resource {
 				Required:    true,
 				Description: "The IP Address that will be linked to the Host record",
 			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All the IPv4 and IPv6 addresses linked to the Host record",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -67,6 +76,8 @@ func DataSourceHostRecord() *schema.Resource {
 }
 
 func dataSourceHostRecordRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "fqdn")
 	configuration := d.Get("configuration").(string)
 
 	view := d.Get("view").(string)
@@ -90,9 +101,9 @@ func dataSourceHostRecordRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(msg)
 	}
 
-	ipLinked := utils.GetPropertyValue("addresses", hostRecord.Properties)
+	ipLinked := splitHostAddresses(utils.GetPropertyValue("addresses", hostRecord.Properties))
 
-	if !(strings.Contains(ipLinked, ipAddress)) {
+	if !contains(ipLinked, normalizeIPAddress(ipAddress)) {
 		msg := fmt.Sprintf("Getting Host record %s failed: IP Address %s isn't matching", fqdnName, ipAddress)
 		log.Debug(msg)
 		return fmt.Errorf(msg)
@@ -123,6 +134,7 @@ func dataSourceHostRecordRead(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(strconv.Itoa(hostRecord.BAMId))
 	d.Set("zone", zone)
+	d.Set("addresses", ipLinked)
 	d.Set("ttl", ttl)
 	log.Debugf("Completed reading Host record %s", fqdnName)
 
@@ -0,0 +1,226 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceIPAddresses The assigned IP addresses of a Network or of a range within it
+func DataSourceIPAddresses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIPAddressesRead,
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Configuration. Getting the addresses in the default Configuration if doesn't specify",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Network address in CIDR format",
+			},
+			"start_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"end_address"},
+				Description:  "The first address of the range within the Network to list",
+			},
+			"end_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start_address"},
+				Description:  "The last address of the range within the Network to list",
+			},
+			"states": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The states the addresses must be in, e.g. STATIC, RESERVED, DHCP_RESERVED or GATEWAY. All the states if doesn't specify",
+			},
+			"property_filter": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The properties the addresses must have, as a map of property name to value",
+			},
+			"page_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "The number of addresses fetched from BAM per request",
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if size := v.(int); size < 1 || size > 10000 {
+						errs = append(errs, fmt.Errorf("%s must be between 1 and 10000, got %d", k, size))
+					}
+					return
+				},
+			},
+			"allowed_property_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Optional list of property keys to keep when filtering.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "IP version: ipv4 or ipv6. Detected from the Network, ipv4 otherwise",
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if version := v.(string); version != entities.IPV4 && version != entities.IPV6 {
+						errs = append(errs, fmt.Errorf("%s must be %s or %s, got %q", k, entities.IPV4, entities.IPV6, version))
+					}
+					return
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of addresses matching the filters",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The addresses matching the filters, in the order of BAM",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_records": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The absolute names of the Host records linked to the address",
+						},
+						"properties": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Pipe-separated key=value properties (filtered).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIPAddressesRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
+
+	configuration := d.Get("configuration").(string)
+	network := d.Get("network").(string)
+	start := d.Get("start_address").(string)
+	end := d.Get("end_address").(string)
+	ipVersion := getDataSourceIPVersion(d, network, entities.IPV4)
+
+	objMgr := GetObjManager(m)
+
+	addresses, err := objMgr.GetIPAddresses(configuration, network, start, end, ipVersion, d.Get("page_size").(int))
+	if err != nil {
+		msg := fmt.Sprintf("Getting the IP addresses of the Network %s failed: %s", network, err)
+		log.Error(msg)
+		return fmt.Errorf(msg)
+	}
+
+	states := make([]string, 0)
+	for _, state := range d.Get("states").(*schema.Set).List() {
+		states = append(states, strings.ToUpper(state.(string)))
+	}
+	sort.Strings(states)
+	filter := utils.ExpandStringMap(d.Get("property_filter"))
+	addresses = filterIPAddresses(addresses, states, filter)
+
+	flattened := make([]map[string]interface{}, 0, len(addresses))
+	for _, address := range addresses {
+		filtered := utils.FilterDataSouceProperties(d, utils.ParseProperties(address.Properties))
+		flattened = append(flattened, map[string]interface{}{
+			"address":      address.Address,
+			"state":        utils.GetPropertyValue("state", address.Properties),
+			"mac_address":  getIPAddressMAC(address),
+			"name":         address.Name,
+			"host_records": address.HostRecords,
+			"properties":   utils.JoinProperties(filtered),
+		})
+	}
+
+	filterKeys := make([]string, 0, len(filter))
+	for key, value := range filter {
+		filterKeys = append(filterKeys, key+"="+value)
+	}
+	sort.Strings(filterKeys)
+	sum := sha1.Sum([]byte(strings.Join([]string{
+		configuration, network, start, end, ipVersion, strings.Join(states, ","), strings.Join(filterKeys, ","),
+	}, "|")))
+	d.SetId(hex.EncodeToString(sum[:]))
+	d.Set("ip_version", ipVersion)
+	d.Set("total_count", len(flattened))
+	if err := d.Set("addresses", flattened); err != nil {
+		return fmt.Errorf("setting addresses failed: %w", err)
+	}
+
+	return nil
+}
+
+// filterIPAddresses Get the addresses in one of the states, if any given, and having all the properties
+func filterIPAddresses(addresses []entities.IPAddress, states []string, properties map[string]string) []entities.IPAddress {
+	matches := make([]entities.IPAddress, 0)
+	for _, address := range addresses {
+		if len(states) > 0 {
+			state := utils.GetPropertyValue("state", address.Properties)
+			matched := false
+			for _, s := range states {
+				if strings.EqualFold(s, state) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				continue
+			}
+		}
+		matched := true
+		for key, value := range properties {
+			if utils.GetPropertyValue(key, address.Properties) != value {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, address)
+		}
+	}
+	return matches
+}
+
+// getIPAddressMAC Get the MAC address of the address, returned either as a field or as a property
+func getIPAddressMAC(address entities.IPAddress) string {
+	if address.Mac != "" {
+		return address.Mac
+	}
+	return utils.GetPropertyValue("macAddress", address.Properties)
+}
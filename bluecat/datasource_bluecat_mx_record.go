@@ -0,0 +1,133 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strconv"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceMXRecord The MX record data source
+func DataSourceMXRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMXRecordRead,
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Configuration. Getting the MX record in the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The view which contains the details of the zone. If not provided, record will be got under default view",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Zone in which you want to get a MX record",
+			},
+			"absolute_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the MX record. Must be FQDN if the Zone is not provided",
+			},
+			"linked_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mail exchanger host that the MX record links to",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The preference of the mail exchanger",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The TTL value",
+			},
+			"bam_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The entity id of the record within BAM",
+			},
+			"properties": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Pipe-separated key=value properties (filtered).",
+			},
+			"properties_raw": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unfiltered raw properties returned by BAM.",
+			},
+			"allowed_property_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Optional list of property keys to keep when filtering.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMXRecordRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
+
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := d.Get("zone").(string)
+	absoluteName := d.Get("absolute_name").(string)
+	if len(zone) > 0 {
+		absoluteName = getFQDN(absoluteName, zone)
+	}
+
+	objMgr := GetObjManager(m)
+
+	mxRecord, err := objMgr.GetMXRecord(configuration, view, absoluteName)
+	if err != nil {
+		msg := fmt.Sprintf("Getting MX record %s failed: %s", absoluteName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+
+	if len(zone) == 0 {
+		zone = getZoneFromRRName(absoluteName)
+	}
+
+	ttl := -1
+	ttlStr := utils.GetPropertyValue("ttl", mxRecord.Properties)
+	if ttlStr != "" {
+		if ttlInt, err := strconv.Atoi(ttlStr); err == nil {
+			ttl = ttlInt
+		}
+	}
+
+	// Parse BAM properties
+	bamProps := utils.ParseProperties(mxRecord.Properties)
+	d.Set("properties_raw", mxRecord.Properties)
+
+	filtered := utils.FilterDataSouceProperties(d, bamProps)
+
+	// Write clean properties string back
+	if err := d.Set("properties", utils.JoinProperties(filtered)); err != nil {
+		return fmt.Errorf("setting properties failed: %w", err)
+	}
+	d.SetId(strconv.Itoa(mxRecord.BAMId))
+
+	d.Set("absolute_name", absoluteName)
+	d.Set("zone", zone)
+	d.Set("ttl", ttl)
+	d.Set("bam_id", mxRecord.BAMId)
+	setMXRecordData(d, mxRecord)
+
+	return nil
+}
@@ -2,37 +2,107 @@ package bluecat
 
 import (
 	"fmt"
+	"math/big"
+	"net"
+	"sort"
 	"strconv"
+	"strings"
 	"terraform-provider-bluecat/bluecat/entities"
 	"terraform-provider-bluecat/bluecat/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// DataSourceIPv4Network The IPv4 Network
 func DataSourceIPv4Network() *schema.Resource {
+	return dataSourceNetwork(entities.IPV4)
+}
+
+// DataSourceIPv6Network The IPv6 Network
+func DataSourceIPv6Network() *schema.Resource {
+	return dataSourceNetwork(entities.IPV6)
+}
+
+// dataSourceNetwork The Network looked up by its CIDR, an address within it, its name or its UDFs,
+// of the IP version of the data source unless ip_version or the CIDR/address tells otherwise
+func dataSourceNetwork(ipVersion string) *schema.Resource {
+	lookups := []string{"cidr", "address", "name", "udf"}
 	return &schema.Resource{
-		Read: dataSourceIPv4NetworkRead,
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dataSourceNetworkRead(d, m, ipVersion)
+		},
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The Configuration. Getting the IPv4 Network in the default Configuration if doesn't specify",
+				Computed:    true,
+				Description: "The Configuration. Getting the Network in the default Configuration if doesn't specify",
 			},
 			"cidr": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The network address in CIDR format",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookups,
+				Description:  "The network address in CIDR format",
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: lookups,
+				Description:  "An IP address within the Network, to get the Network containing it",
 			},
 			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookups,
+				Description:  "The Network name. Used to look up the Network if neither the CIDR nor an address is given",
+			},
+			"udf": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: lookups,
+				Description:  "The user-defined fields the Network must have, as a map of field name to value. Used to look up the Network if neither the CIDR nor an address is given",
+			},
+			"parent_block": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The Network name",
+				Computed:    true,
+				Description: "The parent Block of the Network in CIDR format. Narrows the lookup by name or UDFs to the Networks of the Block",
 			},
 			"gateway": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Gateway address",
 			},
+			"prefix": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The prefix length of the Network",
+			},
+			"dhcp_ranges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The DHCP ranges inside the Network, as start-end",
+			},
+			"total_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of addresses of the Network, as a string since the IPv6 counts don't fit a number",
+			},
+			"allocated_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of allocated addresses of the Network",
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of the addresses of the Network which are allocated",
+			},
 			"properties": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -52,32 +122,92 @@ func DataSourceIPv4Network() *schema.Resource {
 			"ip_version": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Network's IP version",
+				Computed:    true,
+				Description: fmt.Sprintf("Network's IP version: ipv4 or ipv6. Detected from the CIDR or the address, %s otherwise", ipVersion),
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if version := v.(string); version != entities.IPV4 && version != entities.IPV6 {
+						errs = append(errs, fmt.Errorf("%s must be %s or %s, got %q", k, entities.IPV4, entities.IPV6, version))
+					}
+					return
+				},
 			},
 		},
 	}
 }
 
-func dataSourceIPv4NetworkRead(d *schema.ResourceData, m interface{}) error {
+// getDataSourceIPVersion Get the IP version given, or the one of the address, or the default one
+func getDataSourceIPVersion(d *schema.ResourceData, address string, defaultVersion string) string {
+	if ipVersion := d.Get("ip_version").(string); ipVersion != "" {
+		return ipVersion
+	}
+	return getAddressIPVersion(address, defaultVersion)
+}
 
-	network := entities.Network{}
-	if !network.InitNetwork(d) {
-		log.Error(network.InitError)
-		return fmt.Errorf(network.InitError)
+// getAddressIPVersion Get the IP version of the address or CIDR, or the default one if it isn't an address
+func getAddressIPVersion(address string, defaultVersion string) string {
+	if ip := net.ParseIP(strings.Split(address, "/")[0]); ip != nil {
+		if ip.To4() != nil {
+			return entities.IPV4
+		}
+		return entities.IPV6
 	}
+	return defaultVersion
+}
 
-	connector := m.(*utils.Connector)
-	objMgr := new(utils.ObjectManager)
-	objMgr.Connector = connector
+func dataSourceNetworkRead(d *schema.ResourceData, m interface{}, defaultVersion string) error {
+	applyProviderDefaults(d, m, "configuration")
 
-	retrievedNetwork, err := objMgr.GetNetwork(&network)
+	configuration := d.Get("configuration").(string)
+	cidr := d.Get("cidr").(string)
+	address := d.Get("address").(string)
+	parentBlock := d.Get("parent_block").(string)
+	lookup := cidr
+	if lookup == "" {
+		lookup = address
+	}
+	ipVersion := getDataSourceIPVersion(d, lookup, defaultVersion)
+
+	objMgr := GetObjManager(m)
+
+	var retrievedNetwork *entities.Network
+	var err error
+	switch {
+	case cidr != "":
+		retrievedNetwork, err = objMgr.GetNetwork(&entities.Network{Configuration: configuration, CIDR: cidr, IPVersion: ipVersion})
+	case address != "":
+		retrievedNetwork, err = objMgr.GetNetworkByAddress(configuration, address, ipVersion)
+	default:
+		retrievedNetwork, err = findNetwork(objMgr, configuration, parentBlock, ipVersion, d.Get("name").(string), utils.ExpandStringMap(d.Get("udf")))
+	}
 	if err != nil {
-		msg := fmt.Sprintf("Getting Network %s failed: %s", retrievedNetwork.CIDR, err)
+		msg := fmt.Sprintf("Getting Network %s failed: %s", lookup, err)
+		log.Error(msg)
+		return fmt.Errorf(msg)
+	}
+
+	dhcpRanges, err := objMgr.GetDHCPRanges(configuration, retrievedNetwork.CIDR, ipVersion)
+	if err != nil && !utils.IsNotFoundErr(err) {
+		msg := fmt.Sprintf("Getting the DHCP ranges of the Network %s failed: %s", retrievedNetwork.CIDR, err)
 		log.Error(msg)
 		return fmt.Errorf(msg)
 	}
+	ranges := make([]string, 0, len(dhcpRanges))
+	for _, dhcpRange := range dhcpRanges {
+		ranges = append(ranges, fmt.Sprintf("%s-%s", dhcpRange.Start, dhcpRange.End))
+	}
+
+	if parentBlock == "" {
+		networkAddress := strings.Split(retrievedNetwork.CIDR, "/")[0]
+		block, err := objMgr.GetBlock(configuration, networkAddress, "0", ipVersion)
+		if err != nil {
+			log.Debugf("Getting the parent Block of the Network %s failed: %s", retrievedNetwork.CIDR, err)
+		} else if ipVersion == entities.IPV6 {
+			parentBlock = block.GetIPv6BlockFromPropsPrefix()
+		} else {
+			parentBlock = block.AddressCIDR()
+		}
+	}
 
-	gateway := utils.GetPropertyValue("gateway", retrievedNetwork.Properties)
 	// Parse BAM properties
 	bamProps := utils.ParseProperties(retrievedNetwork.Properties)
 	d.Set("properties_raw", retrievedNetwork.Properties)
@@ -91,8 +221,84 @@ func dataSourceIPv4NetworkRead(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(strconv.Itoa(retrievedNetwork.NetWorkId))
 
+	d.Set("cidr", retrievedNetwork.CIDR)
 	d.Set("name", retrievedNetwork.Name)
-	d.Set("gateway", gateway)
+	d.Set("gateway", utils.GetPropertyValue("gateway", retrievedNetwork.Properties))
+	d.Set("ip_version", ipVersion)
+	d.Set("parent_block", parentBlock)
+	d.Set("dhcp_ranges", ranges)
+	if parts := strings.Split(retrievedNetwork.CIDR, "/"); len(parts) == 2 {
+		prefix, _ := strconv.Atoi(parts[1])
+		d.Set("prefix", prefix)
+	}
+	setAddressUsage(d, retrievedNetwork.Usage)
 
 	return nil
 }
+
+// findNetwork Find the only Network having the name and the UDFs
+func findNetwork(objMgr *utils.ObjectManager, configuration string, block string, ipVersion string, name string, udf map[string]string) (*entities.Network, error) {
+	networks, err := objMgr.GetNetworks(configuration, block, ipVersion)
+	if err != nil {
+		return nil, err
+	}
+	matches := filterNetworks(networks, name, udf)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no %s Network named %q with the UDFs %v", ipVersion, name, udf)
+	case 1:
+		return &matches[0], nil
+	}
+	cidrs := make([]string, 0, len(matches))
+	for _, network := range matches {
+		cidrs = append(cidrs, network.CIDR)
+	}
+	sort.Strings(cidrs)
+	return nil, fmt.Errorf("%d %s Networks named %q with the UDFs %v: %s", len(matches), ipVersion, name, udf, strings.Join(cidrs, ", "))
+}
+
+// filterNetworks Get the Networks having the name, if given, and all the UDFs
+func filterNetworks(networks []entities.Network, name string, udf map[string]string) []entities.Network {
+	matches := make([]entities.Network, 0)
+	for _, network := range networks {
+		if name != "" && network.Name != name {
+			continue
+		}
+		matched := true
+		for key, value := range udf {
+			if utils.GetPropertyValue(key, network.Properties) != value {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, network)
+		}
+	}
+	return matches
+}
+
+// setAddressUsage Set the address counts and the utilization of the Block or the Network
+func setAddressUsage(d *schema.ResourceData, usage *entities.AddressUsage) {
+	if usage == nil {
+		return
+	}
+	d.Set("total_addresses", usage.Total.String())
+	d.Set("allocated_addresses", usage.Allocated.String())
+	d.Set("utilization", getUtilization(usage.Total.String(), usage.Allocated.String()))
+}
+
+// getUtilization Get the percentage of the addresses which are allocated, rounded to 2 decimals
+func getUtilization(total string, allocated string) float64 {
+	totalCount, ok := new(big.Float).SetString(total)
+	if !ok || totalCount.Sign() <= 0 {
+		return 0
+	}
+	allocatedCount, ok := new(big.Float).SetString(allocated)
+	if !ok {
+		return 0
+	}
+	percent, _ := new(big.Float).Quo(new(big.Float).Mul(allocatedCount, big.NewFloat(100)), totalCount).Float64()
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(percent, 'f', 2, 64), 64)
+	return rounded
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceNetworkUsage The address counts of one or more Networks and Blocks
+func DataSourceNetworkUsage() *schema.Resource {
+	lookups := []string{"networks", "blocks"}
+	return &schema.Resource{
+		Read: dataSourceNetworkUsageRead,
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Configuration. Getting the usage in the default Configuration if doesn't specify",
+			},
+			"networks": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: lookups,
+				Description:  "The IPv4 and IPv6 Networks in CIDR format",
+			},
+			"blocks": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: lookups,
+				Description:  "The IPv4 and IPv6 Blocks in CIDR format",
+			},
+			"usage": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The address counts of the Networks then the Blocks, in the given order. The counts are strings since the IPv6 ones don't fit a number",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "network or block",
+						},
+						"ip_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allocated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"static": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reserved": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dhcp_reserved": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dhcp_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"free": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"largest_free_run": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The number of addresses of the longest run of consecutive free addresses",
+						},
+						"utilization": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The percentage of the addresses which are allocated",
+						},
+					},
+				},
+			},
+			"least_utilized": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CIDR of the Network or Block with the lowest utilization, the one with the most free addresses on a tie",
+			},
+		},
+	}
+}
+
+func dataSourceNetworkUsageRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
+
+	configuration := d.Get("configuration").(string)
+	networks := utils.ExpandStringList(d.Get("networks"))
+	blocks := utils.ExpandStringList(d.Get("blocks"))
+
+	objMgr := GetObjManager(m)
+
+	usages := make([]map[string]interface{}, 0, len(networks)+len(blocks))
+	for _, cidr := range networks {
+		ipVersion := getAddressIPVersion(cidr, entities.IPV4)
+		usage, err := objMgr.GetNetworkUsage(configuration, cidr, ipVersion)
+		if err != nil {
+			msg := fmt.Sprintf("Getting the usage of the Network %s failed: %s", cidr, err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		usages = append(usages, flattenAddressUsage(cidr, "network", ipVersion, usage))
+	}
+	for _, cidr := range blocks {
+		if !strings.Contains(cidr, "/") {
+			msg := fmt.Sprintf("Invalid cidr block %s", cidr)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		ipVersion := getAddressIPVersion(cidr, entities.IPV4)
+		cidrList := strings.Split(cidr, "/")
+		usage, err := objMgr.GetBlockUsage(configuration, cidrList[0], cidrList[1], ipVersion)
+		if err != nil {
+			msg := fmt.Sprintf("Getting the usage of the Block %s failed: %s", cidr, err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		usages = append(usages, flattenAddressUsage(cidr, "block", ipVersion, usage))
+	}
+
+	sum := sha1.Sum([]byte(fmt.Sprintf("%s|%s|%s", configuration, strings.Join(networks, ","), strings.Join(blocks, ","))))
+	d.SetId(hex.EncodeToString(sum[:]))
+	if err := d.Set("usage", usages); err != nil {
+		return fmt.Errorf("setting usage failed: %w", err)
+	}
+	d.Set("least_utilized", getLeastUtilized(usages))
+
+	return nil
+}
+
+// flattenAddressUsage Get the usage entry of the Network or Block, the missing counts being 0
+// and the free count being the unallocated addresses if BAM doesn't return it
+func flattenAddressUsage(cidr string, kind string, ipVersion string, usage *entities.AddressUsage) map[string]interface{} {
+	count := func(n fmt.Stringer) string {
+		if s := n.String(); s != "" {
+			return s
+		}
+		return "0"
+	}
+	total, allocated := count(usage.Total), count(usage.Allocated)
+	free := usage.Free.String()
+	if free == "" {
+		free = "0"
+		totalCount, ok1 := new(big.Int).SetString(total, 10)
+		allocatedCount, ok2 := new(big.Int).SetString(allocated, 10)
+		if ok1 && ok2 && totalCount.Cmp(allocatedCount) > 0 {
+			free = new(big.Int).Sub(totalCount, allocatedCount).String()
+		}
+	}
+	return map[string]interface{}{
+		"cidr":             cidr,
+		"type":             kind,
+		"ip_version":       ipVersion,
+		"total":            total,
+		"allocated":        allocated,
+		"static":           count(usage.Static),
+		"reserved":         count(usage.Reserved),
+		"dhcp_reserved":    count(usage.DHCPReserved),
+		"dhcp_range":       count(usage.DHCPRange),
+		"free":             free,
+		"largest_free_run": count(usage.LargestFreeRun),
+		"utilization":      getUtilization(total, allocated),
+	}
+}
+
+// getLeastUtilized Get the CIDR of the usage entry with the lowest utilization, the one with the most free addresses on a tie
+func getLeastUtilized(usages []map[string]interface{}) string {
+	var least map[string]interface{}
+	var leastFree *big.Int
+	for _, usage := range usages {
+		free, ok := new(big.Int).SetString(usage["free"].(string), 10)
+		if !ok {
+			free = new(big.Int)
+		}
+		if least == nil {
+			least, leastFree = usage, free
+			continue
+		}
+		utilization, leastUtilization := usage["utilization"].(float64), least["utilization"].(float64)
+		if utilization < leastUtilization || (utilization == leastUtilization && free.Cmp(leastFree) > 0) {
+			least, leastFree = usage, free
+		}
+	}
+	if least == nil {
+		return ""
+	}
+	return least["cidr"].(string)
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The kinds of the next available objects to preview
+const (
+	nextAvailableIP      = "ip"
+	nextAvailableNetwork = "network"
+	nextAvailableBlock   = "block"
+)
+
+// nextAvailableNotReserved Appended to the descriptions, since the candidates may be taken by anyone before being used
+const nextAvailableNotReserved = "Nothing is reserved in BAM: the candidates may be taken by someone else before they are used"
+
+// DataSourceNextAvailableIP The next available addresses of a Network, previewed without being reserved
+func DataSourceNextAvailableIP() *schema.Resource {
+	return dataSourceNextAvailable(nextAvailableIP)
+}
+
+// DataSourceNextAvailableNetwork The next available Networks of a Block, previewed without being created
+func DataSourceNextAvailableNetwork() *schema.Resource {
+	return dataSourceNextAvailable(nextAvailableNetwork)
+}
+
+// DataSourceNextAvailableBlock The next available Blocks of a Block, previewed without being created
+func DataSourceNextAvailableBlock() *schema.Resource {
+	return dataSourceNextAvailable(nextAvailableBlock)
+}
+
+// dataSourceNextAvailable The next available addresses of a Network, or Networks or Blocks of a Block,
+// given by BAM in the order it would allocate them
+func dataSourceNextAvailable(kind string) *schema.Resource {
+	parent, result, excluded := "parent_block", "cidr", "Networks in CIDR format"
+	if kind == nextAvailableBlock {
+		excluded = "Blocks in CIDR format"
+	}
+	if kind == nextAvailableIP {
+		parent, result, excluded = "network", "ip_address", "addresses, ranges such as 10.0.0.1-10.0.0.9 and CIDRs"
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"configuration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The Configuration. Getting the candidates in the default Configuration if doesn't specify",
+		},
+		parent: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: fmt.Sprintf("The parent of the %s candidates in CIDR format", kind),
+		},
+		"limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     1,
+			Description: "The number of candidates to get",
+			ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+				if limit := v.(int); limit < 1 || limit > 100 {
+					errs = append(errs, fmt.Errorf("%s must be between 1 and 100, got %d", k, limit))
+				}
+				return
+			},
+		},
+		"exclude": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: fmt.Sprintf("The %s to skip", excluded),
+		},
+		"ip_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("IP version: ipv4 or ipv6. Detected from the %s, ipv4 otherwise", parent),
+			ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+				if version := v.(string); version != entities.IPV4 && version != entities.IPV6 {
+					errs = append(errs, fmt.Errorf("%s must be %s or %s, got %q", k, entities.IPV4, entities.IPV6, version))
+				}
+				return
+			},
+		},
+		"candidates": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The candidates in the order BAM would allocate them. " + nextAvailableNotReserved,
+		},
+		result: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The first candidate. " + nextAvailableNotReserved,
+		},
+	}
+	if kind == nextAvailableIP {
+		resourceSchema["exclude_dhcp_ranges"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether or not to keep the candidates out of the DHCP ranges of the network, as bluecat_ip_allocation does",
+		}
+	} else {
+		resourceSchema["size"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: fmt.Sprintf("The size of the %s: the number of addresses, a power of 2, for IPv4, the prefix length for IPv6", kind),
+		}
+	}
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dataSourceNextAvailableRead(d, m, kind, parent, result)
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func dataSourceNextAvailableRead(d *schema.ResourceData, m interface{}, kind string, parent string, result string) error {
+	applyProviderDefaults(d, m, "configuration")
+
+	next := entities.NextAvailable{
+		Configuration: d.Get("configuration").(string),
+		Parent:        d.Get(parent).(string),
+		Limit:         d.Get("limit").(int),
+	}
+	next.IPVersion = getDataSourceIPVersion(d, next.Parent, entities.IPV4)
+	exclude, err := normalizeIPExclusions(utils.ExpandStringList(d.Get("exclude")), next.IPVersion)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid exclusions of the next available %s: %s", kind, err)
+		log.Error(msg)
+		return fmt.Errorf(msg)
+	}
+	next.Exclude = exclude
+
+	objMgr := GetObjManager(m)
+
+	var candidates []string
+	switch kind {
+	case nextAvailableIP:
+		next.ExcludeDHCPRange = d.Get("exclude_dhcp_ranges").(bool)
+		candidates, err = objMgr.GetNextAvailableIPs(next)
+	default:
+		next.Size = d.Get("size").(string)
+		if err := validateNextNetworkSize(next.Size, next.IPVersion, next.Parent); err != nil {
+			msg := fmt.Sprintf("Invalid size of the next available %s: %s", kind, err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		if kind == nextAvailableNetwork {
+			candidates, err = objMgr.GetNextAvailableNetworks(next)
+		} else {
+			candidates, err = objMgr.GetNextAvailableBlocks(next)
+		}
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Getting the next available %s of %s failed: %s", kind, next.Parent, err)
+		log.Error(msg)
+		return fmt.Errorf(msg)
+	}
+	if len(candidates) == 0 {
+		msg := fmt.Sprintf("No %s available in %s", kind, next.Parent)
+		log.Error(msg)
+		return fmt.Errorf(msg)
+	}
+	if len(candidates) > next.Limit {
+		candidates = candidates[:next.Limit]
+	}
+	log.Debugf("Next available %s candidates of %s, not reserved: %v", kind, next.Parent, candidates)
+
+	sum := sha1.Sum([]byte(strings.Join([]string{
+		kind, next.Configuration, next.Parent, next.IPVersion, next.Size, strings.Join(candidates, ","),
+	}, "|")))
+	d.SetId(hex.EncodeToString(sum[:]))
+	d.Set("ip_version", next.IPVersion)
+	d.Set(result, candidates[0])
+	if err := d.Set("candidates", candidates); err != nil {
+		return fmt.Errorf("setting candidates failed: %w", err)
+	}
+
+	return nil
+}
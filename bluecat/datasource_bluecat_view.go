@@ -14,11 +14,13 @@ func DataSourceView() *schema.Resource {
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Getting the Zone in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, zone will be got under default view",
 			},
 			"deployable": {
@@ -53,6 +55,7 @@ func DataSourceView() *schema.Resource {
 }
 
 func dataSourceViewRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 
 	configuration := d.Get("configuration").(string)
 	viewName := d.Get("view").(string)
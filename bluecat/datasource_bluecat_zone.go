@@ -15,11 +15,13 @@ func DataSourceZone() *schema.Resource {
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Getting the Zone in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, zone will be got under default view",
 			},
 			"zone": {
@@ -59,6 +61,7 @@ func DataSourceZone() *schema.Resource {
 }
 
 func dataSourceZoneRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -0,0 +1,69 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceZoneExport The records of a BAM zone, rendered as a zone file
+func DataSourceZoneExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZoneExportRead,
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Configuration. Getting the Zone in the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The view which contains the details of the zone. If not provided, zone will be got under default view",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The absolute name of zone or sub zone",
+			},
+			"ignore_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The record types to leave out, such as SOA and NS. GLUE stands for the address records of the delegated names",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The records of the zone as a zone file",
+			},
+			"records": zoneFileRecordsSchema(),
+		},
+	}
+}
+
+func dataSourceZoneExportRead(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	zone := strings.ToLower(strings.TrimSuffix(d.Get("zone").(string), "."))
+
+	objMgr := GetObjManager(m)
+
+	records, err := getExistingZoneRecords(d, objMgr)
+	if err != nil {
+		log.Debug(err)
+		return err
+	}
+
+	d.SetId(zone)
+	d.Set("content", renderZoneFile(zone, records))
+	if err := d.Set("records", flattenZoneFileRecords(records, zone)); err != nil {
+		return fmt.Errorf("setting records failed: %w", err)
+	}
+	log.Debugf("Exported %d records of the zone %s", len(records), zone)
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneFileRecordsSchema The records read from or written to a zone file
+func zoneFileRecordsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The records of the zone",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name, the type and the data of the record, with its occurrence number such as #2 when they repeat, unique in the zone. Use it as the key of for_each",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the record relative to the zone, @ for the zone apex",
+				},
+				"absolute_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The FQDN of the record",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the record",
+				},
+				"data": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The data of the record, the domain names being absolute without the trailing dot",
+				},
+				"ttl": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The TTL value, -1 if the zone file doesn't set it",
+				},
+			},
+		},
+	}
+}
+
+// DataSourceZoneFile The records of a zone file in the RFC 1035 format
+func DataSourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZoneFileRead,
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The text of the zone file, such as file(\"example.com.zone\")",
+			},
+			"origin": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The origin of the zone file, the first $ORIGIN if not provided. The names of the records are relative to it",
+			},
+			"ignore_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The record types to leave out, such as SOA and NS. GLUE stands for the address records of the delegated names",
+			},
+			"records": zoneFileRecordsSchema(),
+		},
+	}
+}
+
+func dataSourceZoneFileRead(d *schema.ResourceData, m interface{}) error {
+	content := d.Get("content").(string)
+	records, zone, err := parseZoneFile(content, d.Get("origin").(string))
+	if err != nil {
+		msg := fmt.Sprintf("Parsing the zone file failed: %s", err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	records = filterIgnoredZoneRecords(records, getIgnoreTypes(d))
+
+	sum := sha1.Sum([]byte(content))
+	d.SetId(hex.EncodeToString(sum[:]))
+	d.Set("origin", zone)
+	if err := d.Set("records", flattenZoneFileRecords(records, zone)); err != nil {
+		return fmt.Errorf("setting records failed: %w", err)
+	}
+	log.Debugf("Read %d records of the zone file of %s", len(records), zone)
+	return nil
+}
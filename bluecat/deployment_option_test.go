@@ -0,0 +1,97 @@
+package bluecat
+
+import (
+	"reflect"
+	"terraform-provider-bluecat/bluecat/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeploymentOptionImporter(t *testing.T) {
+	cases := []struct {
+		id         string
+		target     string
+		ref        string
+		optionType string
+		name       string
+		server     string
+	}{
+		{"zone:example.com:dns:allow-query:bdds1.example.com", "zone", "example.com", "dns", "allow-query", "bdds1.example.com"},
+		{"view:Internal:dns:recursion:", "view", "Internal", "dns", "recursion", ""},
+		{"network:10.0.0.0/24:dhcp_client:router:", "network", "10.0.0.0/24", "dhcp_client", "router", ""},
+		{"block:2001:db8::/32:dhcp_service:default-lease-time:bdds1.example.com", "block", "2001:db8::/32", "dhcp_service", "default-lease-time", "bdds1.example.com"},
+		{"ip_address:10.0.0.10:dhcp_client:host-name:", "ip_address", "10.0.0.10", "dhcp_client", "host-name", ""},
+	}
+	resource := ResourceDeploymentOption()
+	for _, c := range cases {
+		data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+		data.SetId(c.id)
+		state, err := resource.Importer.State(data, nil)
+		if err != nil {
+			t.Fatalf("unexpected import error for %s: %s", c.id, err)
+		}
+		imported := state[0]
+		if got := imported.Get(c.target).(string); got != c.ref {
+			t.Errorf("expected %s %s for %s, got %q", c.target, c.ref, c.id, got)
+		}
+		if got := imported.Get("option_type").(string); got != c.optionType {
+			t.Errorf("expected the option type %s for %s, got %q", c.optionType, c.id, got)
+		}
+		if got := imported.Get("name").(string); got != c.name {
+			t.Errorf("expected the option %s for %s, got %q", c.name, c.id, got)
+		}
+		if got := imported.Get("server_fqdn").(string); got != c.server {
+			t.Errorf("expected the server %q for %s, got %q", c.server, c.id, got)
+		}
+		if _, id := getDeploymentOptionTarget(imported); id != c.id {
+			t.Errorf("expected the ID %s once imported, got %s", c.id, id)
+		}
+	}
+	for _, id := range []string{"example.com", "zone:example.com:dns:allow-query", "zone::dns:allow-query:", "zone:example.com:dhcp:router:", "server:example.com:dns:allow-query:"} {
+		data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+		data.SetId(id)
+		if _, err := resource.Importer.State(data, nil); err == nil {
+			t.Errorf("expected an error for the ID %q", id)
+		}
+	}
+}
+
+func TestDeploymentOptionTarget(t *testing.T) {
+	resource := ResourceDeploymentOption()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"configuration": "Demo",
+		"view":          "Internal",
+		"ip_address":    "2001:db8::10",
+		"option_type":   "DHCP_CLIENT",
+		"name":          "dns-server",
+		"values":        []interface{}{"2001:db8::1", " 2001:db8::2"},
+	})
+	target, _ := getDeploymentOptionTarget(data)
+	if target.ResourceType != "ip_address" || target.IPVersion != "ipv6" || target.View != "" {
+		t.Fatalf("expected an IPv6 address without a view, got %+v", target)
+	}
+	if target.OptionType != "dhcp_client" || target.ServerID != utils.DeploymentOptionAllServersID {
+		t.Fatalf("expected a dhcp_client option on all the servers, got %+v", target)
+	}
+	if err := resolveDeploymentOptionServer(data, nil, &target); err != nil {
+		t.Fatalf("unexpected error for a DHCP option on an address: %s", err)
+	}
+	if value := getDeploymentOptionValue(data); value != "2001:db8::1,2001:db8::2" {
+		t.Fatalf("expected the joined values, got %q", value)
+	}
+	if values := splitDeploymentOptionValue(" 10.0.0.1, 10.0.0.2,"); !reflect.DeepEqual(values, []string{"10.0.0.1", "10.0.0.2"}) {
+		t.Fatalf("expected the split values, got %v", values)
+	}
+
+	data = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"zone":        "example.com",
+		"option_type": "dhcp_service",
+		"name":        "default-lease-time",
+		"value":       "86400",
+	})
+	target, _ = getDeploymentOptionTarget(data)
+	if err := resolveDeploymentOptionServer(data, nil, &target); err == nil {
+		t.Fatal("expected an error for a DHCP option on a zone")
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dhcpDeploymentService Get the DHCP service deploying the objects of the IP version
+func dhcpDeploymentService(ipVersion string) string {
+	if ipVersion == entities.IPV6 {
+		return entities.DeployServiceDHCPv6
+	}
+	return entities.DeployServiceDHCP
+}
+
+// deployDHCPObject Deploy the DHCP object if to_deploy is set, and keep the status in last_deployment_status.
+// getBAMID looks up the ID of the object to deploy, it's only called when the object is deployed.
+func deployDHCPObject(d *schema.ResourceData, objMgr *utils.ObjectManager, ipVersion string, description string, getBAMID func() (int, error)) error {
+	if !utils.ParseDeploymentValue(d.Get("to_deploy").(string)) {
+		return nil
+	}
+	bamID, err := getBAMID()
+	if err == nil && bamID <= 0 {
+		err = fmt.Errorf("the BAM ID is unknown")
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Error deploying %s: %s", description, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	status, err := objMgr.DeployServiceObjects([]int{bamID}, d.Get("batch_mode").(string), dhcpDeploymentService(ipVersion))
+	d.Set("last_deployment_status", status)
+	if err != nil {
+		msg := fmt.Sprintf("Error deploying %s: %s", description, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	log.Debugf("Successfully deployed. %s", status)
+	return nil
+}
@@ -0,0 +1,80 @@
+package bluecat
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNormalizeMACAddress(t *testing.T) {
+	cases := map[string]string{
+		"00:11:22:AA:bb:CC": "00:11:22:aa:bb:cc",
+		"00-11-22-aa-bb-cc": "00:11:22:aa:bb:cc",
+		"0011.22aa.bbcc":    "00:11:22:aa:bb:cc",
+		"001122AABBCC":      "00:11:22:aa:bb:cc",
+		"00:11:22:aa:bb":    "",
+		"00:11:22:aa:bb:zz": "",
+		"host":              "",
+	}
+	for mac, expected := range cases {
+		if got := normalizeMACAddress(mac); got != expected {
+			t.Errorf("expected %q to be normalized to %q, got %q", mac, expected, got)
+		}
+	}
+}
+
+func TestDHCPReservationParseId(t *testing.T) {
+	network, mac, err := dhcpReservationParseId("10.0.0.0/24/00-11-22-AA-BB-CC")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if network != "10.0.0.0/24" || mac != "00:11:22:aa:bb:cc" {
+		t.Errorf("unexpected network %q and MAC address %q", network, mac)
+	}
+	network, _, err = dhcpReservationParseId("2001:db8::/64/00:11:22:aa:bb:cc")
+	if err != nil || network != "2001:db8::/64" {
+		t.Errorf("unexpected IPv6 network %q: %v", network, err)
+	}
+	for _, invalid := range []string{"00:11:22:aa:bb:cc", "10.0.0.0/24", "10.0.0.0/00:11:22:aa:bb:cc", "10.0.0.0/24/host"} {
+		if _, _, err := dhcpReservationParseId(invalid); err == nil {
+			t.Errorf("expected an error for the ID %q", invalid)
+		}
+	}
+}
+
+func TestGetDHCPReservationOptions(t *testing.T) {
+	resource := ResourceDHCPReservation()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"network":         "10.0.0.0/24",
+		"mac_address":     "00:11:22:aa:bb:cc",
+		"hostname":        "pxe-01",
+		"next_server":     "10.0.0.5",
+		"boot_file":       "pxelinux.0",
+		"client_options":  map[string]interface{}{"domain-name-servers": "10.0.0.2,10.0.0.3"},
+		"service_options": map[string]interface{}{"ping-check": "true"},
+	})
+	client, service, err := getDHCPReservationOptions(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(client, map[string]string{"host-name": "pxe-01", "domain-name-servers": "10.0.0.2,10.0.0.3"}) {
+		t.Errorf("unexpected client options %v", client)
+	}
+	if !reflect.DeepEqual(service, map[string]string{"next-server": "10.0.0.5", "filename": "pxelinux.0", "ping-check": "true"}) {
+		t.Errorf("unexpected service options %v", service)
+	}
+	if configured := configuredDHCPReservationOptions(data, "dhcp_client", "client_options"); !reflect.DeepEqual(configured, client) {
+		t.Errorf("expected the configured client options %v, got %v", client, configured)
+	}
+
+	data = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"network":        "10.0.0.0/24",
+		"mac_address":    "00:11:22:aa:bb:cc",
+		"hostname":       "pxe-01",
+		"client_options": map[string]interface{}{"host-name": "pxe-02"},
+	})
+	if _, _, err := getDHCPReservationOptions(data); err == nil {
+		t.Error("expected an error for the host-name option set twice")
+	}
+}
@@ -0,0 +1,81 @@
+package bluecat
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeploymentRoleImporter(t *testing.T) {
+	cases := []struct {
+		id     string
+		target string
+		ref    string
+	}{
+		{"zone:example.com:bdds1.example.com", "zone", "example.com"},
+		{"view:Internal:bdds1.example.com", "view", "Internal"},
+		{"network:10.0.0.0/24:bdds1.example.com", "network", "10.0.0.0/24"},
+		{"block:2001:db8::/32:bdds1.example.com", "block", "2001:db8::/32"},
+	}
+	resource := ResourceDNSDeploymentRole()
+	for _, c := range cases {
+		data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+		data.SetId(c.id)
+		state, err := resource.Importer.State(data, nil)
+		if err != nil {
+			t.Fatalf("unexpected import error for %s: %s", c.id, err)
+		}
+		imported := state[0]
+		if got := imported.Get(c.target).(string); got != c.ref {
+			t.Errorf("expected %s %s for %s, got %q", c.target, c.ref, c.id, got)
+		}
+		if got := imported.Get("server_fqdn").(string); got != "bdds1.example.com" {
+			t.Errorf("expected the server bdds1.example.com for %s, got %q", c.id, got)
+		}
+		if _, id := getDeploymentRoleTarget(imported); id != c.id {
+			t.Errorf("expected the ID %s once imported, got %s", c.id, id)
+		}
+	}
+	for _, id := range []string{"example.com", "zone:example.com", "zone::bdds1", "server:example.com:bdds1"} {
+		data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+		data.SetId(id)
+		if _, err := resource.Importer.State(data, nil); err == nil {
+			t.Errorf("expected an error for the ID %q", id)
+		}
+	}
+}
+
+func TestDeploymentRoleTarget(t *testing.T) {
+	resource := ResourceDNSDeploymentRole()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"configuration": "Demo",
+		"view":          "Internal",
+		"block":         "2001:db8::/32",
+		"server_fqdn":   "bdds1.example.com",
+		"role_type":     "DHCP",
+		"role":          "primary",
+	})
+	target, _ := getDeploymentRoleTarget(data)
+	if target.ResourceType != "block" || target.IPVersion != "ipv6" || target.View != "" {
+		t.Fatalf("expected an IPv6 block without a view, got %+v", target)
+	}
+	if roleType, role, err := getDeploymentRoleName(data, target); err != nil || roleType != "dhcp" || role != "MASTER" {
+		t.Fatalf("expected the dhcp role MASTER, got %s %s %v", roleType, role, err)
+	}
+
+	data = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"zone":        "example.com",
+		"server_fqdn": "bdds1.example.com",
+		"role_type":   "dhcp",
+		"role":        "PRIMARY",
+	})
+	target, _ = getDeploymentRoleTarget(data)
+	if _, _, err := getDeploymentRoleName(data, target); err == nil {
+		t.Fatal("expected an error for a DHCP role on a zone")
+	}
+	data.Set("role_type", "dns")
+	data.Set("role", "secondary_stealth")
+	if _, role, err := getDeploymentRoleName(data, target); err != nil || role != "SLAVE_STEALTH" {
+		t.Fatalf("expected the dns role SLAVE_STEALTH, got %s %v", role, err)
+	}
+}
@@ -8,3 +8,12 @@ const (
 	// AllocateDHCPReserved Allocate the IP Address for DHCP
 	AllocateDHCPReserved string = "MAKE_DHCP_RESERVED"
 )
+
+const (
+	// DeployServiceDNS Deploy the DNS service
+	DeployServiceDNS string = "DNS"
+	// DeployServiceDHCP Deploy the DHCPv4 service
+	DeployServiceDHCP string = "DHCP"
+	// DeployServiceDHCPv6 Deploy the DHCPv6 service
+	DeployServiceDHCPv6 string = "DHCPv6"
+)
@@ -8,6 +8,9 @@ type DeploymentRole struct {
 	Configuration string `json:"-"`
 	View          string `json:"-"`
 	Zone          string `json:"-"`
+	ResourceType  string `json:"-"`
+	ResourceRef   string `json:"-"`
+	IPVersion     string `json:"-"`
 	ServerFQDN    string `json:"server_fqdn,omitempty"`
 	RoleType      string `json:"role_type,omitempty"`
 	Role          string `json:"role,omitempty"`
@@ -35,6 +38,33 @@ type DeploymentOption struct {
 	IPVersion     string `json:"-"`
 	Name          string `json:"name,omitempty"`
 	Value         string `json:"value,omitempty"`
+	OptionType    string `json:"option_type,omitempty"`
 	ServerID      int    `json:"-"`
+	ServerFQDN    string `json:"server_fqdn,omitempty"`
 	Properties    string `json:"properties,omitempty"`
 }
+
+// DeploymentStatus the selective deployment status entity
+type DeploymentStatus struct {
+	BAMBase `json:"-"`
+	Token   string                   `json:"-"`
+	Status  string                   `json:"status,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Servers []ServerDeploymentStatus `json:"servers,omitempty"`
+}
+
+// ServerDeploymentStatus the deployment status of one server
+type ServerDeploymentStatus struct {
+	Server  string                   `json:"server,omitempty"`
+	Status  string                   `json:"status,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Objects []ObjectDeploymentStatus `json:"objects,omitempty"`
+}
+
+// ObjectDeploymentStatus the deployment status of one object on a server
+type ObjectDeploymentStatus struct {
+	ID      int    `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
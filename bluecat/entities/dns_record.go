@@ -77,6 +77,17 @@ type GenericRecord struct {
 	BatchMode     string `json:"batch_mode,omitempty"`
 }
 
+// ResourceRecords The list of the resource records of a name and a type
+type ResourceRecords struct {
+	BAMBase       `json:"-"`
+	Configuration string          `json:"-"`
+	View          string          `json:"-"`
+	Zone          string          `json:"-"`
+	AbsoluteName  string          `json:"-"`
+	TypeRR        string          `json:"-"`
+	Records       []GenericRecord `json:"resource_records,omitempty"`
+}
+
 // SRVRecord SRV record entity
 type SRVRecord struct {
 	BAMBase       `json:"-"`
@@ -96,6 +107,23 @@ type SRVRecord struct {
 	BatchMode     string `json:"batch_mode,omitempty"`
 }
 
+// MXRecord MX record entity
+type MXRecord struct {
+	BAMBase       `json:"-"`
+	Configuration string `json:"-"`
+	View          string `json:"-"`
+	Zone          string `json:"-"`
+	AbsoluteName  string `json:"absolute_name,omitempty"`
+	LinkedRecord  string `json:"linked_record,omitempty"`
+	Priority      int    `json:"priority"`
+	TTL           int    `json:"ttl,omitempty"`
+	Properties    string `json:"properties,omitempty"`
+	Name          string `json:"name,omitempty"`
+	BAMId         int    `json:"id,omitempty"`
+	BAMType       string `json:"type,omitempty"`
+	BatchMode     string `json:"batch_mode,omitempty"`
+}
+
 // External Host Record record entity
 type ExternalHostRecord struct {
 	BAMBase       `json:"-"`
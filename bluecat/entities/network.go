@@ -3,6 +3,7 @@
 package entities
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -16,16 +17,30 @@ const IPV6 = "ipv6"
 // Block IPv4/IPv6 Block entity
 type Block struct {
 	BAMBase       `json:"-"`
-	Configuration string `json:"-"`
-	ParentBlock   string `json:"parent_block"`
-	Name          string `json:"name"`
-	Address       string `json:"address"`
-	CIDR          string `json:"cidr_notation"`
-	Properties    string `json:"properties,omitempty"`
-	BlockId       int    `json:"id,omitempty"`
-	AllocatedId   string `json:"allocatedId"`
-	IPVersion     string `json:"ip_version,omitempty"`
-	Size          string `json:"size"`
+	Configuration string        `json:"-"`
+	ParentBlock   string        `json:"parent_block"`
+	Name          string        `json:"name"`
+	Address       string        `json:"address"`
+	CIDR          string        `json:"cidr_notation"`
+	Properties    string        `json:"properties,omitempty"`
+	BlockId       int           `json:"id,omitempty"`
+	AllocatedId   string        `json:"allocatedId"`
+	IPVersion     string        `json:"ip_version,omitempty"`
+	Size          string        `json:"size"`
+	Usage         *AddressUsage `json:"usage,omitempty"`
+}
+
+// AddressUsage The address counts of a Block or a Network, numbers as large as the ones of the IPv6 networks
+type AddressUsage struct {
+	Total        json.Number `json:"total,omitempty"`
+	Allocated    json.Number `json:"allocated,omitempty"`
+	Static       json.Number `json:"static,omitempty"`
+	Reserved     json.Number `json:"reserved,omitempty"`
+	DHCPReserved json.Number `json:"dhcp_reserved,omitempty"`
+	DHCPRange    json.Number `json:"dhcp_range,omitempty"`
+	Free         json.Number `json:"free,omitempty"`
+	// LargestFreeRun The number of addresses of the longest run of consecutive free addresses
+	LargestFreeRun json.Number `json:"largest_free_run,omitempty"`
 }
 
 func (block *Block) InitBlock(blockMap *schema.ResourceData) {
@@ -66,19 +81,20 @@ func getResourceIPVersion(resourceMap *schema.ResourceData) string {
 // Network IPv4 Network entity
 type Network struct {
 	BAMBase       `json:"-"`
-	Configuration string `json:"-"`
-	BlockAddr     string `json:"-"`
-	Name          string `json:"name"`
-	CIDR          string `json:"cidr"`
-	Gateway       string `json:"gateway"`
-	Properties    string `json:"properties"`
-	Template      string `json:"template"`
-	ParentBlock   string `json:"parent_block"`
-	Size          string `json:"size"`
-	NetWorkId     int    `json:"id"`
-	AllocatedId   string `json:"allocatedId"`
-	IPVersion     string `json:"ip_version" default:"ipv4"`
-	InitError     string `json:"nil"`
+	Configuration string        `json:"-"`
+	BlockAddr     string        `json:"-"`
+	Name          string        `json:"name"`
+	CIDR          string        `json:"cidr"`
+	Gateway       string        `json:"gateway"`
+	Properties    string        `json:"properties"`
+	Template      string        `json:"template"`
+	ParentBlock   string        `json:"parent_block"`
+	Size          string        `json:"size"`
+	NetWorkId     int           `json:"id"`
+	AllocatedId   string        `json:"allocatedId"`
+	IPVersion     string        `json:"ip_version" default:"ipv4"`
+	Usage         *AddressUsage `json:"usage,omitempty"`
+	InitError     string        `json:"nil"`
 }
 
 func (network *Network) InitNetwork(networkMap *schema.ResourceData) bool {
@@ -129,10 +145,33 @@ type IPAddress struct {
 	Name          string `json:"name,omitempty"`
 	Properties    string `json:"properties,omitempty"`
 	IPVersion     string `json:"ip_version,omitempty"`
+	BAMId         int    `json:"id,omitempty"`
+	// AllocationToken Identifies the next available address across retries
+	AllocationToken string `json:"allocation_token,omitempty"`
+	// Offset, Direction, Exclude and ExcludeDHCPRange Choose the next available address
+	Offset           int      `json:"offset,omitempty"`
+	Direction        string   `json:"direction,omitempty"`
+	Exclude          []string `json:"exclude,omitempty"`
+	ExcludeDHCPRange bool     `json:"exclude_dhcp_range,omitempty"`
+	// HostRecords The absolute names of the Host records linked to the address, as listed
+	HostRecords []string `json:"host_records,omitempty"`
 
 	InitError string `json:"nil"`
 }
 
+// IPAddressPage The page of the assigned IP addresses of a Network to be listed,
+// from Start to End if given, skipping the first Offset ones
+type IPAddressPage struct {
+	BAMBase       `json:"-"`
+	Configuration string `json:"-"`
+	Network       string `json:"-"`
+	IPVersion     string `json:"-"`
+	Start         string `json:"start,omitempty"`
+	End           string `json:"end,omitempty"`
+	Offset        int    `json:"offset"`
+	Limit         int    `json:"limit"`
+}
+
 func (ipAddress *IPAddress) InitIPAddress(ipAddressMap *schema.ResourceData) bool {
 
 	ipAddress.IPVersion = getResourceIPVersion(ipAddressMap)
@@ -171,6 +210,19 @@ func (ipAddress *IPAddress) SetAction() {
 	}
 }
 
+// NextAvailable The next available addresses, Networks or Blocks of the parent Network or Block
+// to be previewed, nothing being reserved. Size is the one of the Networks or Blocks
+type NextAvailable struct {
+	BAMBase          `json:"-"`
+	Configuration    string   `json:"-"`
+	Parent           string   `json:"-"`
+	IPVersion        string   `json:"-"`
+	Size             string   `json:"size,omitempty"`
+	Limit            int      `json:"limit"`
+	Exclude          []string `json:"exclude,omitempty"`
+	ExcludeDHCPRange bool     `json:"exclude_dhcp_range,omitempty"`
+}
+
 type DHCPRange struct {
 	BAMBase       `json:"-"`
 	Configuration string `json:"-"`
@@ -181,6 +233,7 @@ type DHCPRange struct {
 	Name          string `json:"name,omitempty"`
 	Properties    string `json:"properties,omitempty"`
 	IPVersion     string `json:"ip_version" default:"ipv4"`
+	BAMId         int    `json:"id,omitempty"`
 
 	InitError string `json:"nil"`
 }
@@ -11,3 +11,12 @@ type Server struct {
 	Properties    string `json:"properties,omitempty"`
 	ServerId      int    `json:"id,omitempty"`
 }
+
+// ServerDeployment the full or quick deployment of the services on a server
+type ServerDeployment struct {
+	BAMBase        `json:"-"`
+	Configuration  string   `json:"-"`
+	ServerID       int      `json:"-"`
+	DeploymentType string   `json:"deployment_type"`
+	Services       []string `json:"services,omitempty"`
+}
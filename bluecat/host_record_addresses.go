@@ -0,0 +1,145 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// hostAddress One of the IPv4 or IPv6 addresses of a host record
+type hostAddress struct {
+	Address       string
+	ReverseRecord bool
+}
+
+// normalizeIPAddress Write the IP address in its canonical form, so 2001:DB8:0::1 and 2001:db8::1 are the same
+func normalizeIPAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	}
+	return address
+}
+
+// splitHostAddresses Split the comma-joined addresses returned by the Gateway
+func splitHostAddresses(addresses string) []string {
+	result := make([]string, 0)
+	for _, address := range strings.FieldsFunc(addresses, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if address = normalizeIPAddress(address); address != "" {
+			result = append(result, address)
+		}
+	}
+	return result
+}
+
+// sameHostAddresses Whether both comma-joined lists hold the same addresses, whatever the order and the form
+func sameHostAddresses(old, new string) bool {
+	oldAddresses := splitHostAddresses(old)
+	newAddresses := splitHostAddresses(new)
+	if len(oldAddresses) != len(newAddresses) {
+		return false
+	}
+	sort.Strings(oldAddresses)
+	sort.Strings(newAddresses)
+	for i := range oldAddresses {
+		if oldAddresses[i] != newAddresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getHostAddresses Get the addresses of the host record from the addresses set, sorted by address
+func getHostAddresses(d *schema.ResourceData) []hostAddress {
+	addresses := make([]hostAddress, 0)
+	set, ok := d.Get("addresses").(*schema.Set)
+	if !ok {
+		return addresses
+	}
+	for _, item := range set.List() {
+		value := item.(map[string]interface{})
+		addresses = append(addresses, hostAddress{
+			Address:       normalizeIPAddress(value["address"].(string)),
+			ReverseRecord: value["reverse_record"].(bool),
+		})
+	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i].Address < addresses[j].Address })
+	return addresses
+}
+
+// buildHostAddressesRequest Get the comma-joined addresses, the addresses needing a reverse record and the
+// properties to send for the host record. When the addresses agree on the reverse record the reverseRecord
+// property is enough, otherwise the addresses needing a reverse record are listed in reverse_record
+func buildHostAddressesRequest(addresses []hostAddress, properties string) (string, string, string, error) {
+	if len(addresses) == 0 {
+		return "", "", properties, fmt.Errorf("at least one address is required")
+	}
+	all := make([]string, 0, len(addresses))
+	reverse := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if net.ParseIP(address.Address) == nil {
+			return "", "", properties, fmt.Errorf("invalid IP address %q", address.Address)
+		}
+		all = append(all, address.Address)
+		if address.ReverseRecord {
+			reverse = append(reverse, address.Address)
+		}
+	}
+	properties = removeAttributeFromProperties("reverseRecord", properties)
+	reverseRecord := ""
+	switch len(reverse) {
+	case len(all):
+		properties = fmt.Sprintf("%sreverseRecord=true|", properties)
+	case 0:
+		properties = fmt.Sprintf("%sreverseRecord=false|", properties)
+	default:
+		reverseRecord = strings.Join(reverse, ",")
+	}
+	return strings.Join(all, ","), reverseRecord, properties, nil
+}
+
+// flattenHostAddresses Build the addresses set from the addresses returned by the Gateway.
+// The reverse record of each address is read from reverse_record when the Gateway returns it,
+// otherwise from the reverseRecord property, keeping the known values when they differ per address
+func flattenHostAddresses(remote []string, reverseRecord string, reverseProperty string, known []hostAddress) []interface{} {
+	reverse := make(map[string]bool)
+	for _, address := range splitHostAddresses(reverseRecord) {
+		reverse[address] = true
+	}
+	knownReverse := make(map[string]bool)
+	mixed := false
+	for i, address := range known {
+		knownReverse[address.Address] = address.ReverseRecord
+		if i > 0 && address.ReverseRecord != known[0].ReverseRecord {
+			mixed = true
+		}
+	}
+
+	result := make([]interface{}, 0, len(remote))
+	for _, address := range remote {
+		value := true
+		previous, isKnown := knownReverse[address]
+		switch {
+		case reverseRecord != "":
+			value = reverse[address]
+		case mixed && isKnown:
+			value = previous
+		case strings.EqualFold(reverseProperty, "false"):
+			value = false
+		case strings.EqualFold(reverseProperty, "true"):
+			value = true
+		case isKnown:
+			value = previous
+		}
+		result = append(result, map[string]interface{}{
+			"address":        address,
+			"reverse_record": value,
+		})
+	}
+	return result
+}
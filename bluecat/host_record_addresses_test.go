@@ -0,0 +1,108 @@
+package bluecat
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSameHostAddressesIgnoresOrderAndForm(t *testing.T) {
+	if !sameHostAddresses("10.0.0.1,2001:DB8:0::1", "2001:db8::1, 10.0.0.1") {
+		t.Fatal("expected the same addresses whatever the order and the IPv6 form")
+	}
+	if sameHostAddresses("10.0.0.1,10.0.0.2", "10.0.0.1") {
+		t.Fatal("expected a difference when an address is missing")
+	}
+	if sameHostAddresses("10.0.0.1", "10.0.0.10") {
+		t.Fatal("expected a difference between 10.0.0.1 and 10.0.0.10")
+	}
+}
+
+func TestBuildHostAddressesRequest(t *testing.T) {
+	cases := []struct {
+		addresses  []hostAddress
+		properties string
+		ipAddress  string
+		reverse    string
+		expected   string
+	}{
+		{
+			addresses:  []hostAddress{{"10.0.0.1", true}, {"2001:db8::1", true}},
+			properties: "comments=dual|reverseRecord=false|",
+			ipAddress:  "10.0.0.1,2001:db8::1",
+			expected:   "comments=dual|reverseRecord=true|",
+		},
+		{
+			addresses: []hostAddress{{"10.0.0.1", false}, {"2001:db8::1", false}},
+			ipAddress: "10.0.0.1,2001:db8::1",
+			expected:  "reverseRecord=false|",
+		},
+		{
+			addresses: []hostAddress{{"10.0.0.1", true}, {"10.0.0.2", false}, {"2001:db8::1", true}},
+			ipAddress: "10.0.0.1,10.0.0.2,2001:db8::1",
+			reverse:   "10.0.0.1,2001:db8::1",
+			expected:  "",
+		},
+	}
+	for _, c := range cases {
+		ipAddress, reverse, properties, err := buildHostAddressesRequest(c.addresses, c.properties)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if ipAddress != c.ipAddress || reverse != c.reverse || properties != c.expected {
+			t.Errorf("expected (%s, %s, %s), got (%s, %s, %s)", c.ipAddress, c.reverse, c.expected, ipAddress, reverse, properties)
+		}
+	}
+	if _, _, _, err := buildHostAddressesRequest([]hostAddress{{"10.0.0.300", true}}, ""); err == nil {
+		t.Fatal("expected an error for an invalid address")
+	}
+}
+
+func TestFlattenHostAddresses(t *testing.T) {
+	remote := splitHostAddresses("2001:db8::1,10.0.0.1")
+	// the Gateway lists the addresses with a reverse record
+	got := flattenHostAddresses(remote, "10.0.0.1", "", nil)
+	expected := []interface{}{
+		map[string]interface{}{"address": "2001:db8::1", "reverse_record": false},
+		map[string]interface{}{"address": "10.0.0.1", "reverse_record": true},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	// only the host-wide property is returned, the known per-address values are kept when they differ
+	known := []hostAddress{{"10.0.0.1", true}, {"2001:db8::1", false}}
+	got = flattenHostAddresses(remote, "", "true", known)
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	// a host-wide change is picked up when the known values agree
+	known = []hostAddress{{"10.0.0.1", true}, {"2001:db8::1", true}}
+	got = flattenHostAddresses(remote, "", "false", known)
+	for _, item := range got {
+		if item.(map[string]interface{})["reverse_record"].(bool) {
+			t.Fatalf("expected no reverse record, got %v", got)
+		}
+	}
+}
+
+func TestHostRecordAddressesSetIsOrderInsensitive(t *testing.T) {
+	resource := ResourceHostRecord()
+	first := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"absolute_name": "dual.example.com",
+		"addresses": []interface{}{
+			map[string]interface{}{"address": "10.0.0.1", "reverse_record": true},
+			map[string]interface{}{"address": "2001:db8::1", "reverse_record": false},
+		},
+	})
+	second := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"absolute_name": "dual.example.com",
+		"addresses": []interface{}{
+			map[string]interface{}{"address": "2001:db8::1", "reverse_record": false},
+			map[string]interface{}{"address": "10.0.0.1", "reverse_record": true},
+		},
+	})
+	if !reflect.DeepEqual(getHostAddresses(first), getHostAddresses(second)) {
+		t.Fatalf("expected the same addresses, got %v and %v", getHostAddresses(first), getHostAddresses(second))
+	}
+}
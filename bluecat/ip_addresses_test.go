@@ -0,0 +1,46 @@
+package bluecat
+
+import (
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestFilterIPAddresses(t *testing.T) {
+	addresses := []entities.IPAddress{
+		{Address: "10.0.0.1", Properties: "state=GATEWAY|"},
+		{Address: "10.0.0.10", Properties: "state=STATIC|owner=infra|"},
+		{Address: "10.0.0.11", Properties: "state=DHCP_RESERVED|owner=infra|"},
+		{Address: "10.0.0.12", Properties: "state=RESERVED|owner=apps|"},
+	}
+	cases := []struct {
+		states     []string
+		properties map[string]string
+		expected   []string
+	}{
+		{nil, nil, []string{"10.0.0.1", "10.0.0.10", "10.0.0.11", "10.0.0.12"}},
+		{[]string{"STATIC", "DHCP_RESERVED"}, nil, []string{"10.0.0.10", "10.0.0.11"}},
+		{nil, map[string]string{"owner": "infra"}, []string{"10.0.0.10", "10.0.0.11"}},
+		{[]string{"RESERVED"}, map[string]string{"owner": "infra"}, []string{}},
+	}
+	for _, c := range cases {
+		matches := filterIPAddresses(addresses, c.states, c.properties)
+		got := make([]string, 0, len(matches))
+		for _, address := range matches {
+			got = append(got, address.Address)
+		}
+		if len(got) != len(c.expected) {
+			t.Errorf("expected %v for the states %v and the properties %v, got %v", c.expected, c.states, c.properties, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.expected[i] {
+				t.Errorf("expected %v for the states %v and the properties %v, got %v", c.expected, c.states, c.properties, got)
+				break
+			}
+		}
+	}
+
+	if mac := getIPAddressMAC(entities.IPAddress{Properties: "macAddress=00-11-22-33-44-55|"}); mac != "00-11-22-33-44-55" {
+		t.Errorf("expected the MAC address of the properties, got %s", mac)
+	}
+}
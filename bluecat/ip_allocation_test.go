@@ -0,0 +1,78 @@
+package bluecat
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAllocationToken(t *testing.T) {
+	resource := ResourceIPAllocation()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":    "web.example.com",
+		"network": "10.0.0.0/24",
+	})
+	token := getAllocationToken(data, "Demo", "10.0.0.0/24", "web.example.com")
+	if len(token) != 20 {
+		t.Fatalf("expected a token of 20 characters, got %q", token)
+	}
+	if again := getAllocationToken(data, "Demo", "10.0.0.0/24", "WEB.example.com"); again != token {
+		t.Errorf("expected the same token on retry, got %q and %q", token, again)
+	}
+	if other := getAllocationToken(data, "Demo", "10.0.0.0/24", "db.example.com"); other == token {
+		t.Errorf("expected another token for another name, got %q", other)
+	}
+	if other := getAllocationToken(data, "Demo", "10.0.1.0/24", "web.example.com"); other == token {
+		t.Errorf("expected another token for another network, got %q", other)
+	}
+
+	data.Set("allocation_token", "web-01")
+	if configured := getAllocationToken(data, "Demo", "10.0.0.0/24", "web.example.com"); configured != "web-01" {
+		t.Errorf("expected the configured token, got %q", configured)
+	}
+}
+
+func TestNextIPAddressStrategy(t *testing.T) {
+	exclusions, err := normalizeIPExclusions([]string{"10.0.0.1", " 10.0.0.200-10.0.0.210", "10.0.0.64/27"}, entities.IPV4)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(exclusions, []string{"10.0.0.1", "10.0.0.200-10.0.0.210", "10.0.0.64/27"}) {
+		t.Errorf("unexpected IPv4 exclusions %v", exclusions)
+	}
+	exclusions, err = normalizeIPExclusions([]string{"2001:DB8::1", "2001:db8::10-2001:db8::1f", "2001:db8:0:0:1::/80"}, entities.IPV6)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(exclusions, []string{"2001:db8::1", "2001:db8::10-2001:db8::1f", "2001:db8:0:0:1::/80"}) {
+		t.Errorf("unexpected IPv6 exclusions %v", exclusions)
+	}
+	for _, invalid := range []string{"10.0.0.300", "10.0.0.9-10.0.0.1", "2001:db8::1", "10.0.0.0/33", "host"} {
+		if _, err := normalizeIPExclusions([]string{invalid}, entities.IPV4); err == nil {
+			t.Errorf("expected an error for the IPv4 exclusion %q", invalid)
+		}
+	}
+
+	resource := ResourceIPAllocation()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":       "vip.example.com",
+		"network":    "2001:db8::/64",
+		"ip_version": "ipv6",
+		"direction":  "highest",
+		"offset":     2,
+		"exclude":    []interface{}{"2001:db8::ffff"},
+	})
+	address := entities.IPAddress{IPVersion: entities.IPV6}
+	if err := setNextIPAddressStrategy(data, &address); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	body, _ := json.Marshal(address)
+	expected := `"offset":2,"direction":"highest","exclude":["2001:db8::ffff"],"exclude_dhcp_range":true`
+	if !strings.Contains(string(body), expected) {
+		t.Errorf("expected the request body to hold %s, got %s", expected, body)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+)
+
+// ipRangeAllocationAttempts The number of runs tried before giving up finding a contiguous run of free addresses
+const ipRangeAllocationAttempts = 32
+
+// addToIP Get the address n addresses after the given one, nil past the end of the address space
+func addToIP(ip net.IP, n int64) net.IP {
+	size := net.IPv6len
+	if v4 := ip.To4(); v4 != nil {
+		ip, size = v4, net.IPv4len
+	}
+	value := new(big.Int).SetBytes(ip)
+	value.Add(value, big.NewInt(n))
+	if value.Sign() < 0 || value.BitLen() > size*8 {
+		return nil
+	}
+	result := make(net.IP, size)
+	value.FillBytes(result)
+	return result
+}
+
+// getIPRun Get the count consecutive addresses starting at the first one, which must all be in the network
+func getIPRun(network string, first string, count int) ([]string, error) {
+	_, ipNet, err := net.ParseCIDR(network)
+	if err != nil {
+		return nil, fmt.Errorf("invalid network %q: %s", network, err)
+	}
+	start := net.ParseIP(first)
+	if start == nil {
+		return nil, fmt.Errorf("invalid IP address %q", first)
+	}
+	run := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		ip := addToIP(start, int64(i))
+		if ip == nil || !ipNet.Contains(ip) {
+			return nil, fmt.Errorf("the network %s has no %d consecutive addresses from %s", network, count, first)
+		}
+		run = append(run, ip.String())
+	}
+	return run, nil
+}
+
+// getIPRunNames Get the names of the addresses of the run from the name template, %d standing for the index from 1
+func getIPRunNames(template string, count int) []string {
+	names := make([]string, 0, count)
+	if template == "" {
+		return names
+	}
+	for i := 1; i <= count; i++ {
+		if strings.Contains(template, "%d") {
+			names = append(names, strings.ReplaceAll(template, "%d", fmt.Sprint(i)))
+		} else {
+			names = append(names, fmt.Sprintf("%s-%d", template, i))
+		}
+	}
+	return names
+}
@@ -0,0 +1,65 @@
+package bluecat
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestAddToIP(t *testing.T) {
+	cases := []struct {
+		ip       string
+		n        int64
+		expected string
+	}{
+		{"10.0.0.255", 1, "10.0.1.0"},
+		{"10.0.0.10", -10, "10.0.0.0"},
+		{"2001:db8::ffff", 1, "2001:db8::1:0"},
+	}
+	for _, c := range cases {
+		if got := addToIP(net.ParseIP(c.ip), c.n); got.String() != c.expected {
+			t.Errorf("expected %s + %d = %s, got %s", c.ip, c.n, c.expected, got)
+		}
+	}
+	if got := addToIP(net.ParseIP("255.255.255.255"), 1); got != nil {
+		t.Errorf("expected no address past the end of the IPv4 space, got %s", got)
+	}
+	if got := addToIP(net.ParseIP("0.0.0.0"), -1); got != nil {
+		t.Errorf("expected no address before the start of the IPv4 space, got %s", got)
+	}
+}
+
+func TestGetIPRun(t *testing.T) {
+	run, err := getIPRun("10.0.0.0/24", "10.0.0.14", 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(run, []string{"10.0.0.14", "10.0.0.15", "10.0.0.16", "10.0.0.17"}) {
+		t.Errorf("unexpected run %v", run)
+	}
+	run, err = getIPRun("2001:db8::/64", "2001:db8::fffe", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(run, []string{"2001:db8::fffe", "2001:db8::ffff", "2001:db8::1:0"}) {
+		t.Errorf("unexpected IPv6 run %v", run)
+	}
+	if _, err := getIPRun("10.0.0.0/24", "10.0.0.253", 4); err == nil {
+		t.Error("expected an error for a run past the end of the network")
+	}
+	if _, err := getIPRun("10.0.0.0/33", "10.0.0.1", 1); err == nil {
+		t.Error("expected an error for an invalid network")
+	}
+}
+
+func TestGetIPRunNames(t *testing.T) {
+	if names := getIPRunNames("vip-%d.example.com", 3); !reflect.DeepEqual(names, []string{"vip-1.example.com", "vip-2.example.com", "vip-3.example.com"}) {
+		t.Errorf("unexpected names %v", names)
+	}
+	if names := getIPRunNames("node", 2); !reflect.DeepEqual(names, []string{"node-1", "node-2"}) {
+		t.Errorf("unexpected names without %%d %v", names)
+	}
+	if names := getIPRunNames("", 2); len(names) != 0 {
+		t.Errorf("expected no names without a template, got %v", names)
+	}
+}
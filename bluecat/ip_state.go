@@ -0,0 +1,99 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"terraform-provider-bluecat/bluecat/entities"
+)
+
+// ipStates The IP address states by the actions moving an address to them
+var ipStates = map[string]string{
+	entities.AllocateStatic:       "STATIC",
+	entities.AllocateReserved:     "RESERVED",
+	entities.AllocateDHCPReserved: "DHCP_RESERVED",
+}
+
+// ipStateTransition The change of state of an allocated IP address and its side effects, the address being kept
+type ipStateTransition struct {
+	From string
+	To   string
+	// Action The action to send to move the address, empty if the state doesn't change
+	Action string
+	// NeedsMAC Whether or not the target state needs a MAC address
+	NeedsMAC bool
+	// CreateHostRecord Whether or not the Host record of the address is created if missing
+	CreateHostRecord bool
+	// RemoveHostRecord Whether or not the address is unlinked from its Host record, if it has one
+	RemoveHostRecord bool
+	// DeployDNS Whether or not the Host record changes go to the DNS servers
+	DeployDNS bool
+	// DeployDHCP Whether or not the address is deployed to the DHCP servers as a reservation
+	DeployDHCP bool
+	// UndeployDHCP Whether or not the network is deployed to take the reservation off the DHCP servers
+	UndeployDHCP bool
+}
+
+// getIPState Get the IP address state from the state or the action moving an address to it
+func getIPState(stateOrAction string) (string, error) {
+	if state, ok := ipStates[stateOrAction]; ok {
+		return state, nil
+	}
+	for _, state := range ipStates {
+		if state == stateOrAction {
+			return state, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported IP address state %q, expected %s, %s or %s", stateOrAction,
+		entities.AllocateStatic, entities.AllocateReserved, entities.AllocateDHCPReserved)
+}
+
+// getIPStateTransition Get the transition of the address from its current state in BAM to the desired one.
+// A reserved address has no Host record; a static or DHCP reserved one has one when the zone is known.
+func getIPStateTransition(current string, desired string, hasZone bool) (ipStateTransition, error) {
+	from, err := getIPState(current)
+	if err != nil {
+		return ipStateTransition{}, err
+	}
+	to, err := getIPState(desired)
+	if err != nil {
+		return ipStateTransition{}, err
+	}
+	transition := ipStateTransition{
+		From:       from,
+		To:         to,
+		NeedsMAC:   to == "DHCP_RESERVED",
+		DeployDNS:  to == "STATIC",
+		DeployDHCP: to == "DHCP_RESERVED",
+	}
+	if from != to {
+		for action, state := range ipStates {
+			if state == to {
+				transition.Action = action
+			}
+		}
+		transition.UndeployDHCP = from == "DHCP_RESERVED"
+	}
+	if hasZone {
+		transition.CreateHostRecord = to != "RESERVED"
+		transition.RemoveHostRecord = to == "RESERVED"
+		// the Host record leaving the DNS servers is deployed too
+		transition.DeployDNS = transition.DeployDNS || transition.RemoveHostRecord
+	} else {
+		transition.DeployDNS = false
+	}
+	return transition, nil
+}
+
+// getIPStateKept Get the transition of an address whose current state in BAM isn't one getIPStateTransition
+// moves from, e.g. GATEWAY or none: the state is left as it is and, as before the transitions, an existing
+// Host record is linked to the address and the address is deployed for the desired action.
+func getIPStateKept(current string, desired string, hasZone bool) ipStateTransition {
+	to, _ := getIPState(desired)
+	return ipStateTransition{
+		From:       current,
+		To:         current,
+		DeployDNS:  hasZone && to == "STATIC",
+		DeployDHCP: to == "DHCP_RESERVED",
+	}
+}
@@ -0,0 +1,80 @@
+package bluecat
+
+import (
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestGetIPStateTransition(t *testing.T) {
+	cases := []struct {
+		current  string
+		desired  string
+		hasZone  bool
+		expected ipStateTransition
+	}{
+		{"RESERVED", entities.AllocateStatic, true, ipStateTransition{
+			From: "RESERVED", To: "STATIC", Action: entities.AllocateStatic, CreateHostRecord: true, DeployDNS: true,
+		}},
+		{"RESERVED", entities.AllocateDHCPReserved, true, ipStateTransition{
+			From: "RESERVED", To: "DHCP_RESERVED", Action: entities.AllocateDHCPReserved, NeedsMAC: true, CreateHostRecord: true, DeployDHCP: true,
+		}},
+		{"STATIC", entities.AllocateReserved, true, ipStateTransition{
+			From: "STATIC", To: "RESERVED", Action: entities.AllocateReserved, RemoveHostRecord: true, DeployDNS: true,
+		}},
+		{"STATIC", entities.AllocateDHCPReserved, true, ipStateTransition{
+			From: "STATIC", To: "DHCP_RESERVED", Action: entities.AllocateDHCPReserved, NeedsMAC: true, CreateHostRecord: true, DeployDHCP: true,
+		}},
+		{"DHCP_RESERVED", entities.AllocateStatic, true, ipStateTransition{
+			From: "DHCP_RESERVED", To: "STATIC", Action: entities.AllocateStatic, CreateHostRecord: true, DeployDNS: true, UndeployDHCP: true,
+		}},
+		{"DHCP_RESERVED", entities.AllocateReserved, true, ipStateTransition{
+			From: "DHCP_RESERVED", To: "RESERVED", Action: entities.AllocateReserved, RemoveHostRecord: true, DeployDNS: true, UndeployDHCP: true,
+		}},
+		{"STATIC", entities.AllocateStatic, true, ipStateTransition{
+			From: "STATIC", To: "STATIC", CreateHostRecord: true, DeployDNS: true,
+		}},
+		{"DHCP_RESERVED", "DHCP_RESERVED", false, ipStateTransition{
+			From: "DHCP_RESERVED", To: "DHCP_RESERVED", NeedsMAC: true, DeployDHCP: true,
+		}},
+		{"STATIC", entities.AllocateReserved, false, ipStateTransition{
+			From: "STATIC", To: "RESERVED", Action: entities.AllocateReserved,
+		}},
+		{"RESERVED", entities.AllocateStatic, false, ipStateTransition{
+			From: "RESERVED", To: "STATIC", Action: entities.AllocateStatic,
+		}},
+	}
+	for _, c := range cases {
+		transition, err := getIPStateTransition(c.current, c.desired, c.hasZone)
+		if err != nil {
+			t.Fatalf("unexpected error moving from %s to %s: %s", c.current, c.desired, err)
+		}
+		if transition != c.expected {
+			t.Errorf("moving from %s to %s (zone: %t): expected %+v, got %+v", c.current, c.desired, c.hasZone, c.expected, transition)
+		}
+	}
+
+	for _, invalid := range [][2]string{{"GATEWAY", entities.AllocateStatic}, {"STATIC", "MAKE_GATEWAY"}} {
+		if _, err := getIPStateTransition(invalid[0], invalid[1], true); err == nil {
+			t.Errorf("expected an error moving from %s to %s", invalid[0], invalid[1])
+		}
+	}
+}
+
+func TestGetIPStateKept(t *testing.T) {
+	cases := []struct {
+		current  string
+		desired  string
+		hasZone  bool
+		expected ipStateTransition
+	}{
+		{"GATEWAY", entities.AllocateStatic, true, ipStateTransition{From: "GATEWAY", To: "GATEWAY", DeployDNS: true}},
+		{"GATEWAY", entities.AllocateStatic, false, ipStateTransition{From: "GATEWAY", To: "GATEWAY"}},
+		{"", entities.AllocateDHCPReserved, true, ipStateTransition{DeployDHCP: true}},
+		{"DHCP_ALLOCATED", entities.AllocateReserved, true, ipStateTransition{From: "DHCP_ALLOCATED", To: "DHCP_ALLOCATED"}},
+	}
+	for _, c := range cases {
+		if transition := getIPStateKept(c.current, c.desired, c.hasZone); transition != c.expected {
+			t.Errorf("keeping %q for %s (zone: %t): expected %+v, got %+v", c.current, c.desired, c.hasZone, c.expected, transition)
+		}
+	}
+}
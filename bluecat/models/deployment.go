@@ -11,11 +11,7 @@ import (
 func NewDeploymentRole(deploymentRole entities.DeploymentRole) *entities.DeploymentRole {
 	res := deploymentRole
 	res.SetObjectType("deployment_roles")
-	sPath := getRRPrefixPath(deploymentRole.Configuration, deploymentRole.View)
-	if len(deploymentRole.Zone) > 0 {
-		sPath = fmt.Sprintf("%s/zones/%s", sPath, deploymentRole.Zone)
-	}
-	res.SetSubPath(sPath)
+	res.SetSubPath(getDeploymentRoleBasePath(deploymentRole))
 	return &res
 }
 
@@ -35,11 +31,23 @@ func GetDeploymentRoles(deploymentRoles entities.DeploymentRoles) *entities.Depl
 func DeploymentRole(deploymentRole entities.DeploymentRole) *entities.DeploymentRole {
 	res := deploymentRole
 	res.SetObjectType("")
-	sPath := getRRPrefixPath(deploymentRole.Configuration, deploymentRole.View)
-	res.SetSubPath(fmt.Sprintf("%s/zones/%s/server/%s/deployment_roles", sPath, deploymentRole.Zone, deploymentRole.ServerFQDN))
+	res.SetSubPath(fmt.Sprintf("%s/server/%s/deployment_roles", getDeploymentRoleBasePath(deploymentRole), deploymentRole.ServerFQDN))
 	return &res
 }
 
+func getDeploymentRoleBasePath(deploymentRole entities.DeploymentRole) string {
+	switch {
+	case deploymentRole.ResourceType == "block":
+		return fmt.Sprintf("%s/%s_blocks/%s", getPath(deploymentRole.Configuration), deploymentRole.IPVersion, deploymentRole.ResourceRef)
+	case deploymentRole.ResourceType == "network":
+		return fmt.Sprintf("%s/%s_networks/%s", getPath(deploymentRole.Configuration), deploymentRole.IPVersion, deploymentRole.ResourceRef)
+	case deploymentRole.Zone != "":
+		return fmt.Sprintf("%s/zones/%s", getRRPrefixPath(deploymentRole.Configuration, deploymentRole.View), deploymentRole.Zone)
+	default:
+		return getRRPrefixPath(deploymentRole.Configuration, deploymentRole.View)
+	}
+}
+
 // NewDeploymentOption Initialize the new Deployment option to be added
 func NewDeploymentOption(deploymentOption entities.DeploymentOption) *entities.DeploymentOption {
 	res := deploymentOption
@@ -48,6 +56,14 @@ func NewDeploymentOption(deploymentOption entities.DeploymentOption) *entities.D
 	return &res
 }
 
+// DeploymentOptions Initialize the Deployment options of the object to be listed
+func DeploymentOptions(deploymentOption entities.DeploymentOption) *entities.DeploymentOption {
+	res := deploymentOption
+	res.SetObjectType("deployment_options")
+	res.SetSubPath(getDeploymentOptionBasePath(deploymentOption))
+	return &res
+}
+
 // DeploymentOption Initialize the Deployment option to be loaded, updated or deleted
 func DeploymentOption(deploymentOption entities.DeploymentOption) *entities.DeploymentOption {
 	res := deploymentOption
@@ -74,7 +90,17 @@ func getDeploymentOptionBasePath(deploymentOption entities.DeploymentOption) str
 		return fmt.Sprintf("%s/%s_blocks/%s", getPath(deploymentOption.Configuration), deploymentOption.IPVersion, deploymentOption.ResourceRef)
 	case deploymentOption.ResourceType == "network":
 		return fmt.Sprintf("%s/%s_networks/%s", getPath(deploymentOption.Configuration), deploymentOption.IPVersion, deploymentOption.ResourceRef)
+	case deploymentOption.ResourceType == "ip_address":
+		return fmt.Sprintf("%s/%s_addresses/%s", getPath(deploymentOption.Configuration), deploymentOption.IPVersion, deploymentOption.ResourceRef)
 	default:
 		return ""
 	}
 }
+
+// DeploymentStatus Initialize the selective deployment status to be loaded
+func DeploymentStatus(deploymentStatus entities.DeploymentStatus) *entities.DeploymentStatus {
+	res := deploymentStatus
+	res.SetObjectType("")
+	res.SetSubPath(fmt.Sprintf("/deployments/%s", deploymentStatus.Token))
+	return &res
+}
@@ -114,6 +114,27 @@ func NewGenericRecord(genericRecord entities.GenericRecord) *entities.GenericRec
 	return &res
 }
 
+// ResourceRecords Initialize the list of the resource records of a name and a type to be loaded,
+// or of all the resource records of the zone if the name is not provided
+func ResourceRecords(records entities.ResourceRecords) *entities.ResourceRecords {
+	res := records
+	res.SetObjectType("")
+	sPath := fmt.Sprintf("%s/zones/%s/resource_records", getRRPrefixPath(records.Configuration, records.View), records.Zone)
+	if len(records.AbsoluteName) > 0 {
+		sPath = fmt.Sprintf("%s/%s/types/%s", sPath, records.AbsoluteName, records.TypeRR)
+	}
+	res.SetSubPath(sPath)
+	return &res
+}
+
+// ResourceRecord Initialize the resource record to be updated or deleted by its BAM ID
+func ResourceRecord(record entities.GenericRecord) *entities.GenericRecord {
+	res := record
+	res.SetObjectType("")
+	res.SetSubPath(fmt.Sprintf("%s/resource_records/%d", getRRPrefixPath(record.Configuration, record.View), record.BAMId))
+	return &res
+}
+
 // SRVRecord Initialize the SRV record to be loaded, updated or deleted
 func SRVRecord(srvRecord entities.SRVRecord) *entities.SRVRecord {
 	res := srvRecord
@@ -134,6 +155,26 @@ func NewSRVRecord(srvRecord entities.SRVRecord) *entities.SRVRecord {
 	return &res
 }
 
+// MXRecord Initialize the MX record to be loaded, updated or deleted
+func MXRecord(mxRecord entities.MXRecord) *entities.MXRecord {
+	res := mxRecord
+	res.SetObjectType("")
+	res.SetSubPath(fmt.Sprintf("%s/mx_records/%s", getRRPrefixPath(mxRecord.Configuration, mxRecord.View), mxRecord.AbsoluteName))
+	return &res
+}
+
+// NewMXRecord Initialize the new MX record to be added
+func NewMXRecord(mxRecord entities.MXRecord) *entities.MXRecord {
+	res := mxRecord
+	res.SetObjectType("mx_records")
+	sPath := getRRPrefixPath(mxRecord.Configuration, mxRecord.View)
+	if len(mxRecord.Zone) > 0 {
+		sPath = fmt.Sprintf("%s/zones/%s", sPath, mxRecord.Zone)
+	}
+	res.SetSubPath(sPath)
+	return &res
+}
+
 // ExternalHostRecord Initialize the SRV record to be loaded, updated or deleted
 func ExternalHostRecord(externalHostRecord entities.ExternalHostRecord) *entities.ExternalHostRecord {
 	res := externalHostRecord
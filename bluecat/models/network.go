@@ -67,7 +67,7 @@ func NewNetwork(network entities.Network) entities.Network {
 	return res
 }
 
-// NewNextAvailableNetwork Initialize the new next available IPv4 Network to be added
+// NewNextAvailableNetwork Initialize the new next available IPv4/IPv6 Network to be added
 func NewNextAvailableNetwork(network entities.Network) *entities.Network {
 	res := network
 	res.SetObjectType("get_next_network")
@@ -85,18 +85,36 @@ func Network(network entities.Network) *entities.Network {
 	return &res
 }
 
-// NetworkByAllocatedId Initialize the IPv4 Network to be loaded by allocated id
+// Networks Initialize the IPv4/IPv6 Networks to be listed, the ones of the Configuration or of the parent Block
+func Networks(network entities.Network) *entities.Network {
+	res := network
+	res.SetObjectType(fmt.Sprintf("%s_networks", network.IPVersion))
+	if len(network.BlockAddr) == 0 {
+		res.SetSubPath(getPath(res.Configuration))
+	} else {
+		res.SetSubPath(fmt.Sprintf("%s/%s_blocks/%s", getPath(res.Configuration), network.IPVersion, network.BlockAddr))
+	}
+
+	return &res
+}
+
+// NetworkByAllocatedId Initialize the IPv4/IPv6 Network to be loaded by allocated id
 func NetworkByAllocatedId(network entities.Network) *entities.Network {
 	res := network
+	ipVersion := network.IPVersion
+	if ipVersion == "" {
+		ipVersion = entities.IPV4
+	}
 	res.SetObjectType("")
-	res.SetSubPath(fmt.Sprintf("%s/ipv4_blocks/%s/get_network_by_allocated_id/%s", getPath(res.Configuration), network.BlockAddr, network.AllocatedId))
+	res.SetSubPath(fmt.Sprintf("%s/%s_blocks/%s/get_network_by_allocated_id/%s", getPath(res.Configuration), ipVersion, network.BlockAddr, network.AllocatedId))
 
 	return &res
 }
 
 // IP Address
 
-// GetNextIPAddress Initialize the new IPv4 Address for getting next available address
+// GetNextIPAddress Initialize the new IPv4 Address for getting next available address.
+// The offset, the direction and the exclusions of the address tell which one is taken
 func GetNextIPAddress(ipAddr entities.IPAddress) *entities.IPAddress {
 	res := ipAddr
 	if len(ipAddr.Action) == 0 {
@@ -110,6 +128,37 @@ func GetNextIPAddress(ipAddr entities.IPAddress) *entities.IPAddress {
 	return &res
 }
 
+// IPAddressByAllocationToken Initialize the IP Address to be loaded by the allocation token of the next available address
+func IPAddressByAllocationToken(ipAddr entities.IPAddress) *entities.IPAddress {
+	res := ipAddr
+	res.SetObjectType("")
+	res.SetSubPath(
+		fmt.Sprintf("%s/%s_networks/%s/get_ip_by_allocation_token/%s", getIPPath(res.Configuration), ipAddr.IPVersion, ipAddr.CIDR, ipAddr.AllocationToken),
+	)
+
+	return &res
+}
+
+// IPAddressByMAC Initialize the IP Address to be loaded by its MAC address in the network
+func IPAddressByMAC(ipAddr entities.IPAddress) *entities.IPAddress {
+	res := ipAddr
+	res.SetObjectType("")
+	res.SetSubPath(
+		fmt.Sprintf("%s/%s_networks/%s/get_ip_by_mac/%s", getIPPath(res.Configuration), ipAddr.IPVersion, ipAddr.CIDR, ipAddr.Mac),
+	)
+
+	return &res
+}
+
+// IPAddresses Initialize the page of the IP Addresses of the network to be listed
+func IPAddresses(page entities.IPAddressPage) *entities.IPAddressPage {
+	res := page
+	res.SetObjectType(fmt.Sprintf("%s_addresses", page.IPVersion))
+	res.SetSubPath(fmt.Sprintf("%s/%s_networks/%s", getIPPath(res.Configuration), page.IPVersion, page.Network))
+
+	return &res
+}
+
 // IPAddress Initialize the IPv4 Address
 func IPAddress(ipAddr entities.IPAddress) *entities.IPAddress {
 	res := ipAddr
@@ -119,6 +168,35 @@ func IPAddress(ipAddr entities.IPAddress) *entities.IPAddress {
 	return &res
 }
 
+// Next Available
+
+// NextAvailableIPs Initialize the next available IPv4/IPv6 Addresses of the Network to be previewed
+func NextAvailableIPs(next entities.NextAvailable) *entities.NextAvailable {
+	res := next
+	res.SetObjectType("next_available_ips")
+	res.SetSubPath(fmt.Sprintf("%s/%s_networks/%s", getIPPath(res.Configuration), next.IPVersion, next.Parent))
+
+	return &res
+}
+
+// NextAvailableNetworks Initialize the next available IPv4/IPv6 Networks of the Block to be previewed
+func NextAvailableNetworks(next entities.NextAvailable) *entities.NextAvailable {
+	res := next
+	res.SetObjectType("next_available_networks")
+	res.SetSubPath(fmt.Sprintf("%s/%s_blocks/%s", getPath(res.Configuration), next.IPVersion, next.Parent))
+
+	return &res
+}
+
+// NextAvailableBlocks Initialize the next available IPv4/IPv6 Blocks of the Block to be previewed
+func NextAvailableBlocks(next entities.NextAvailable) *entities.NextAvailable {
+	res := next
+	res.SetObjectType("next_available_blocks")
+	res.SetSubPath(fmt.Sprintf("%s/%s_blocks/%s", getPath(res.Configuration), next.IPVersion, next.Parent))
+
+	return &res
+}
+
 // DHCP Range
 
 // NewDHCPRange Initialize the new DHCP Range to be added
@@ -132,6 +210,15 @@ func NewDHCPRange(dhcpRange entities.DHCPRange) *entities.DHCPRange {
 	return &res
 }
 
+// DHCPRanges Initialize the DHCP Ranges of the Network to be listed
+func DHCPRanges(dhcpRange entities.DHCPRange) *entities.DHCPRange {
+	res := dhcpRange
+	res.SetObjectType("dhcp_ranges")
+	res.SetSubPath(fmt.Sprintf("%s/%s_networks/%s", getPath(res.Configuration), dhcpRange.IPVersion, dhcpRange.Network))
+
+	return &res
+}
+
 // DHCPRange Initialize the DHCP Range to be loaded, updated or deleted
 func DHCPRange(dhcpRange entities.DHCPRange) *entities.DHCPRange {
 	res := dhcpRange
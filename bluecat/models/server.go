@@ -13,3 +13,11 @@ func Server(server entities.Server) *entities.Server {
 	res.SetSubPath(fmt.Sprintf("%s/server_fqdn/%s", getPath(res.Configuration), server.ServerFQDN))
 	return &res
 }
+
+// NewServerDeployment Initialize the full or quick deployment of the server
+func NewServerDeployment(serverDeployment entities.ServerDeployment) *entities.ServerDeployment {
+	res := serverDeployment
+	res.SetObjectType("deployments")
+	res.SetSubPath(fmt.Sprintf("%s/servers/%d", getPath(res.Configuration), serverDeployment.ServerID))
+	return &res
+}
@@ -0,0 +1,61 @@
+package bluecat
+
+import (
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestFilterNetworks(t *testing.T) {
+	networks := []entities.Network{
+		{CIDR: "2001:db8:0:1::/64", Name: "nodes", Properties: "site=tor|tier=prod|"},
+		{CIDR: "2001:db8:0:2::/64", Name: "nodes", Properties: "site=yvr|tier=prod|"},
+		{CIDR: "2001:db8:0:3::/64", Name: "storage", Properties: "site=tor|"},
+	}
+	cases := []struct {
+		name     string
+		udf      map[string]string
+		expected []string
+	}{
+		{"nodes", nil, []string{"2001:db8:0:1::/64", "2001:db8:0:2::/64"}},
+		{"nodes", map[string]string{"site": "yvr"}, []string{"2001:db8:0:2::/64"}},
+		{"", map[string]string{"site": "tor"}, []string{"2001:db8:0:1::/64", "2001:db8:0:3::/64"}},
+		{"", map[string]string{"site": "tor", "tier": "prod"}, []string{"2001:db8:0:1::/64"}},
+		{"storage", map[string]string{"tier": "prod"}, []string{}},
+	}
+	for _, c := range cases {
+		matches := filterNetworks(networks, c.name, c.udf)
+		cidrs := make([]string, 0, len(matches))
+		for _, network := range matches {
+			cidrs = append(cidrs, network.CIDR)
+		}
+		if len(cidrs) != len(c.expected) {
+			t.Errorf("expected %v for the name %q and the UDFs %v, got %v", c.expected, c.name, c.udf, cidrs)
+			continue
+		}
+		for i := range cidrs {
+			if cidrs[i] != c.expected[i] {
+				t.Errorf("expected %v for the name %q and the UDFs %v, got %v", c.expected, c.name, c.udf, cidrs)
+				break
+			}
+		}
+	}
+}
+
+func TestGetUtilization(t *testing.T) {
+	cases := []struct {
+		total     string
+		allocated string
+		expected  float64
+	}{
+		{"256", "64", 25},
+		{"254", "1", 0.39},
+		{"18446744073709551616", "9223372036854775808", 50},
+		{"0", "0", 0},
+		{"", "10", 0},
+	}
+	for _, c := range cases {
+		if got := getUtilization(c.total, c.allocated); got != c.expected {
+			t.Errorf("expected %s of %s to be %.2f%%, got %.2f%%", c.allocated, c.total, c.expected, got)
+		}
+	}
+}
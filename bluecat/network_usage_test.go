@@ -0,0 +1,53 @@
+package bluecat
+
+import (
+	"encoding/json"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestFlattenAddressUsage(t *testing.T) {
+	usage := flattenAddressUsage("2001:db8:0:1::/64", "network", entities.IPV6, &entities.AddressUsage{
+		Total:     json.Number("18446744073709551616"),
+		Allocated: json.Number("16"),
+		Static:    json.Number("10"),
+	})
+	expected := map[string]interface{}{
+		"total":            "18446744073709551616",
+		"allocated":        "16",
+		"static":           "10",
+		"reserved":         "0",
+		"dhcp_reserved":    "0",
+		"free":             "18446744073709551600",
+		"largest_free_run": "0",
+		"utilization":      float64(0),
+	}
+	for key, value := range expected {
+		if usage[key] != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, usage[key])
+		}
+	}
+
+	usage = flattenAddressUsage("10.0.0.0/24", "block", entities.IPV4, &entities.AddressUsage{
+		Total:     json.Number("256"),
+		Allocated: json.Number("64"),
+		Free:      json.Number("150"),
+	})
+	if usage["free"] != "150" || usage["utilization"] != float64(25) {
+		t.Errorf("expected the free count returned by BAM and a 25%% utilization, got %v", usage)
+	}
+}
+
+func TestGetLeastUtilized(t *testing.T) {
+	usages := []map[string]interface{}{
+		{"cidr": "10.0.0.0/24", "free": "200", "utilization": float64(20)},
+		{"cidr": "10.0.1.0/24", "free": "240", "utilization": float64(5)},
+		{"cidr": "10.0.2.0/23", "free": "486", "utilization": float64(5)},
+	}
+	if least := getLeastUtilized(usages); least != "10.0.2.0/23" {
+		t.Errorf("expected 10.0.2.0/23, got %s", least)
+	}
+	if least := getLeastUtilized(nil); least != "" {
+		t.Errorf("expected no CIDR without usage, got %s", least)
+	}
+}
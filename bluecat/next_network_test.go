@@ -0,0 +1,46 @@
+package bluecat
+
+import (
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestValidateNextNetworkSize(t *testing.T) {
+	valid := []struct {
+		size        string
+		ipVersion   string
+		parentBlock string
+	}{
+		{"256", entities.IPV4, "10.0.0.0/16"},
+		{"65536", entities.IPV4, "10.0.0.0/16"},
+		{"64", entities.IPV4, "10.0.0.0"},
+		{"64", entities.IPV6, "2001:db8::/48"},
+		{"/56", entities.IPV6, "2001:db8::/48"},
+		{"128", entities.IPV6, "2001:db8::"},
+	}
+	for _, c := range valid {
+		if err := validateNextNetworkSize(c.size, c.ipVersion, c.parentBlock); err != nil {
+			t.Errorf("unexpected error for the %s size %s in %s: %s", c.ipVersion, c.size, c.parentBlock, err)
+		}
+	}
+	invalid := []struct {
+		size        string
+		ipVersion   string
+		parentBlock string
+	}{
+		{"100", entities.IPV4, "10.0.0.0/16"},
+		{"131072", entities.IPV4, "10.0.0.0/16"},
+		{"0", entities.IPV4, "10.0.0.0/16"},
+		{"", entities.IPV4, "10.0.0.0/16"},
+		{"8589934592", entities.IPV4, "10.0.0.0"},
+		{"48", entities.IPV6, "2001:db8::/48"},
+		{"129", entities.IPV6, "2001:db8::/48"},
+		{"18446744073709551616", entities.IPV6, "2001:db8::/48"},
+		{"64", entities.IPV6, "2001:db8::/x"},
+	}
+	for _, c := range invalid {
+		if err := validateNextNetworkSize(c.size, c.ipVersion, c.parentBlock); err == nil {
+			t.Errorf("expected an error for the %s size %s in %s", c.ipVersion, c.size, c.parentBlock)
+		}
+	}
+}
@@ -5,7 +5,9 @@ package bluecat
 import (
 	"context"
 	"fmt"
+	"sync"
 	"terraform-provider-bluecat/bluecat/utils"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -52,6 +54,33 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: "Default is false, to indicate if the password is encrypted",
 			},
+			"default_configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Configuration used by resources and data sources that don't specify one",
+			},
+			"default_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The View used by resources and data sources that don't specify one",
+			},
+			"default_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Zone used by DNS record resources and data sources that don't specify one",
+			},
+			"deferred_deployment": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Default is false. If true, the records marked to_deploy are queued and deployed together by the bluecat_deployment resource",
+			},
+			"deployment_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "Default is 300. The seconds to wait for a selective deployment to complete. 0 returns as soon as the deployment is requested",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"bluecat_host_record":          ResourceHostRecord(),
@@ -63,24 +92,45 @@ func Provider() *schema.Provider {
 			"bluecat_cname_record":         ResourceCNAMERecord(),
 			"bluecat_ip_allocation":        ResourceIPAllocation(),
 			"bluecat_ip_association":       ResourceIPAssociation(),
+			"bluecat_ip_range_allocation":  ResourceIPRangeAllocation(),
 			"bluecat_ptr_record":           ResourcePTRRecord(),
 			"bluecat_txt_record":           ResourceTXTRecord(),
 			"bluecat_srv_record":           ResourceSRVRecord(),
+			"bluecat_mx_record":            ResourceMXRecord(),
+			"bluecat_naptr_record":         ResourceNAPTRRecord(),
+			"bluecat_caa_record":           ResourceCAARecord(),
+			"bluecat_sshfp_record":         ResourceSSHFPRecord(),
+			"bluecat_tlsa_record":          ResourceTLSARecord(),
+			"bluecat_record_set":           ResourceRecordSet(),
+			"bluecat_zone_records":         ResourceZoneRecords(),
+			"bluecat_dns_deployment_role":  ResourceDNSDeploymentRole(),
+			"bluecat_deployment_option":    ResourceDeploymentOption(),
 			"bluecat_external_host_record": ResourceExternalHostRecord(),
 			"bluecat_generic_record":       ResourceGenericRecord(),
 			"bluecat_dhcp_range":           ResourceDHCPRange(),
+			"bluecat_dhcp_reservation":     ResourceDHCPReservation(),
 			"bluecat_zone":                 ResourceZone(),
 			"bluecat_view":                 ResourceView(),
+			"bluecat_deployment":           ResourceDeployment(),
+			"bluecat_server_deployment":    ResourceServerDeployment(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"bluecat_ipv4network":  DataSourceIPv4Network(),
-			"bluecat_ipv6network":  DataSourceIPv4Network(),
-			"bluecat_cname_record": DataSourceCNAMERecord(),
-			"bluecat_host_record":  DataSourceHostRecord(),
-			"bluecat_ipv4block":    DataSourceBlock(),
-			"bluecat_ipv6block":    DataSourceBlock(),
-			"bluecat_zone":         DataSourceZone(),
-			"bluecat_view":         DataSourceView(),
+			"bluecat_ipv4network":            DataSourceIPv4Network(),
+			"bluecat_ipv6network":            DataSourceIPv6Network(),
+			"bluecat_cname_record":           DataSourceCNAMERecord(),
+			"bluecat_host_record":            DataSourceHostRecord(),
+			"bluecat_ipv4block":              DataSourceBlock(),
+			"bluecat_ipv6block":              DataSourceIPv6Block(),
+			"bluecat_zone":                   DataSourceZone(),
+			"bluecat_view":                   DataSourceView(),
+			"bluecat_mx_record":              DataSourceMXRecord(),
+			"bluecat_zone_file":              DataSourceZoneFile(),
+			"bluecat_zone_export":            DataSourceZoneExport(),
+			"bluecat_network_usage":          DataSourceNetworkUsage(),
+			"bluecat_ip_addresses":           DataSourceIPAddresses(),
+			"bluecat_next_available_ip":      DataSourceNextAvailableIP(),
+			"bluecat_next_available_network": DataSourceNextAvailableNetwork(),
+			"bluecat_next_available_block":   DataSourceNextAvailableBlock(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -111,9 +161,42 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		})
 		return nil, diags
 	}
+	conn.Defaults = utils.ProviderDefaults{
+		Configuration: d.Get("default_configuration").(string),
+		View:          d.Get("default_view").(string),
+		Zone:          d.Get("default_zone").(string),
+	}
+	conn.DeferDeployment = d.Get("deferred_deployment").(bool)
+	conn.DeploymentTimeout = time.Duration(d.Get("deployment_timeout").(int)) * time.Second
+	if conn.DeferDeployment {
+		deferredConnectors.Lock()
+		deferredConnectors.connectors = append(deferredConnectors.connectors, conn)
+		deferredConnectors.Unlock()
+	}
 	return conn, diags
 }
 
+// deferredConnectors The connectors with deferred deployment, checked on shutdown for objects left in their queues
+var deferredConnectors struct {
+	sync.Mutex
+	connectors []*utils.Connector
+}
+
+// ReportPendingDeployments Log the objects still queued for deployment when the provider shuts down,
+// i.e. never deployed by a bluecat_deployment resource. Returns their number
+func ReportPendingDeployments() int {
+	deferredConnectors.Lock()
+	defer deferredConnectors.Unlock()
+	pending := 0
+	for _, conn := range deferredConnectors.connectors {
+		for batch, ids := range conn.DeploymentQueue.Drain() {
+			log.Errorf("Object ids %v of service '%s' with batch_mode %s were queued by deferred_deployment but never deployed: add a bluecat_deployment resource depending on them", ids, batch.Service, batch.BatchMode)
+			pending += len(ids)
+		}
+	}
+	return pending
+}
+
 func GetObjManager(m interface{}) *utils.ObjectManager {
 	connector := m.(*utils.Connector)
 	objMgr := new(utils.ObjectManager)
@@ -0,0 +1,93 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setProviderDefaultsDiff plans the provider-level default for every given attribute
+// that isn't set in the resource configuration. Writing the resolved value into the plan
+// keeps it in state, so a later change of the provider default shows up as a diff.
+func setProviderDefaultsDiff(attributes ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		connector, ok := m.(*utils.Connector)
+		if !ok {
+			return nil
+		}
+		rawConfig := d.GetRawConfig()
+		for _, attribute := range attributes {
+			defaultValue := connector.Defaults.Get(attribute)
+			if defaultValue == "" {
+				continue
+			}
+			if !rawConfig.IsNull() && rawConfig.IsKnown() && !rawConfig.GetAttr(attribute).IsNull() {
+				continue
+			}
+			if d.Get(attribute).(string) == defaultValue {
+				continue
+			}
+			if err := d.SetNew(attribute, defaultValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// applyProviderDefaults sets the provider-level default for every given attribute
+// which is still empty. It's used where no plan is available, such as data sources and imports.
+func applyProviderDefaults(d *schema.ResourceData, m interface{}, attributes ...string) {
+	connector, ok := m.(*utils.Connector)
+	if !ok {
+		return
+	}
+	for _, attribute := range attributes {
+		defaultValue := connector.Defaults.Get(attribute)
+		if defaultValue != "" && d.Get(attribute).(string) == "" {
+			d.Set(attribute, defaultValue)
+		}
+	}
+}
+
+// setRecordZoneDefaultDiff plans the provider-level default zone of a record without zone
+// only when its name, held by nameAttribute, is a bare label such as www. A name with a dot
+// keeps deriving its zone from the name, so default_zone never changes its meaning.
+func setRecordZoneDefaultDiff(nameAttribute string) schema.CustomizeDiffFunc {
+	zoneDefault := setProviderDefaultsDiff("zone")
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown(nameAttribute) || !isBareLabel(d.Get(nameAttribute).(string)) {
+			return nil
+		}
+		return zoneDefault(ctx, d, m)
+	}
+}
+
+// applyRecordZoneDefault sets the provider-level default zone of a record without zone
+// whose name, held by nameAttribute, is a bare label
+func applyRecordZoneDefault(d *schema.ResourceData, m interface{}, nameAttribute string) {
+	if isBareLabel(d.Get(nameAttribute).(string)) {
+		applyProviderDefaults(d, m, "zone")
+	}
+}
+
+// isBareLabel Whether or not the record name is a single label, completed by the default zone
+func isBareLabel(name string) bool {
+	return name != "" && !strings.Contains(name, ".")
+}
+
+// customizeDiffAll runs each of the given CustomizeDiff functions in order
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		for _, f := range funcs {
+			if err := f(ctx, d, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
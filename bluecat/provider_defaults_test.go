@@ -0,0 +1,63 @@
+package bluecat
+
+import (
+	"terraform-provider-bluecat/bluecat/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestApplyProviderDefaultsFillsUnsetAttributes(t *testing.T) {
+	// Unset configuration and view fall back to the provider defaults.
+	resource := ResourceHostRecord()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"absolute_name": "host.example.com",
+	})
+	connector := &utils.Connector{Defaults: utils.ProviderDefaults{Configuration: "Demo", View: "Internal"}}
+
+	applyProviderDefaults(data, connector, "configuration", "view", "zone")
+
+	if got := data.Get("configuration").(string); got != "Demo" {
+		t.Fatalf("expected configuration Demo, got %s", got)
+	}
+	if got := data.Get("view").(string); got != "Internal" {
+		t.Fatalf("expected view Internal, got %s", got)
+	}
+	if got := data.Get("zone").(string); got != "" {
+		t.Fatalf("expected empty zone without a provider default, got %s", got)
+	}
+}
+
+func TestApplyProviderDefaultsKeepsConfiguredValues(t *testing.T) {
+	// Values set on the resource always win over the provider defaults.
+	resource := ResourceHostRecord()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"configuration": "Production",
+		"absolute_name": "host.example.com",
+	})
+	connector := &utils.Connector{Defaults: utils.ProviderDefaults{Configuration: "Demo"}}
+
+	applyProviderDefaults(data, connector, "configuration")
+
+	if got := data.Get("configuration").(string); got != "Production" {
+		t.Fatalf("expected configuration Production, got %s", got)
+	}
+}
+
+func TestApplyRecordZoneDefaultOnlyCompletesBareLabels(t *testing.T) {
+	// A dotted name keeps deriving its zone from the name, the default zone only completes a bare label.
+	connector := &utils.Connector{Defaults: utils.ProviderDefaults{Zone: "example.com"}}
+	cases := map[string]string{
+		"www":           "example.com",
+		"www.other.com": "",
+	}
+	for name, expected := range cases {
+		data := schema.TestResourceDataRaw(t, ResourceHostRecord().Schema, map[string]interface{}{
+			"absolute_name": name,
+		})
+		applyRecordZoneDefault(data, connector, "absolute_name")
+		if got := data.Get("zone").(string); got != expected {
+			t.Errorf("expected the zone %q for %s, got %q", expected, name, got)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+)
+
+// normalizeRecordSetValue Write the value of the record in a canonical form, so the values configured and
+// the data returned by BAM can be compared whatever the case, the spacing or the trailing dots
+func normalizeRecordSetValue(typerr string, value string) string {
+	typerr = strings.ToUpper(typerr)
+	switch typerr {
+	case "A", "AAAA":
+		return normalizeIPAddress(value)
+	case "TXT":
+		return formatTXTData(parseTXTData(value))
+	}
+	if rdata, ok := typedRDataTypes[typerr]; ok {
+		if values, err := rdata.Parse(value); err == nil {
+			if data, err := rdata.Format(values); err == nil {
+				return data
+			}
+		}
+	}
+	tokens := strings.Fields(value)
+	for i, token := range tokens {
+		if token != "." {
+			tokens[i] = strings.TrimSuffix(token, ".")
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// sameRecordSetTTL Whether the TTL of the record is the one configured, -1 standing for the TTL of the zone
+func sameRecordSetTTL(ttl int, record entities.GenericRecord) bool {
+	if ttl < 0 {
+		return record.TTL <= 0
+	}
+	return record.TTL == ttl
+}
+
+// planRecordSet Get the values to create and the records to delete so BAM holds exactly the configured values.
+// A record with the wrong TTL is deleted and its value created again
+func planRecordSet(typerr string, values []string, ttl int, existing []entities.GenericRecord) ([]string, []entities.GenericRecord) {
+	wanted := make(map[string]string, len(values))
+	order := make([]string, 0, len(values))
+	for _, value := range values {
+		normalized := normalizeRecordSetValue(typerr, value)
+		if _, ok := wanted[normalized]; !ok {
+			wanted[normalized] = value
+			order = append(order, normalized)
+		}
+	}
+
+	kept := make(map[string]bool, len(existing))
+	remove := make([]entities.GenericRecord, 0)
+	for _, record := range existing {
+		normalized := normalizeRecordSetValue(typerr, genericRecordData(&record))
+		if _, ok := wanted[normalized]; ok && !kept[normalized] && sameRecordSetTTL(ttl, record) {
+			kept[normalized] = true
+			continue
+		}
+		remove = append(remove, record)
+	}
+
+	create := make([]string, 0)
+	for _, normalized := range order {
+		if !kept[normalized] {
+			create = append(create, wanted[normalized])
+		}
+	}
+	return create, remove
+}
+
+// readRecordSetValues Get the values to keep in the state from the records returned by BAM: the configured
+// value when it matches the data of the record once normalized, otherwise the data returned by BAM
+func readRecordSetValues(typerr string, configured []string, records []entities.GenericRecord) []string {
+	known := make(map[string]string, len(configured))
+	for _, value := range configured {
+		known[normalizeRecordSetValue(typerr, value)] = value
+	}
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		data := genericRecordData(&record)
+		if value, ok := known[normalizeRecordSetValue(typerr, data)]; ok {
+			data = value
+		}
+		values = append(values, data)
+	}
+	return values
+}
+
+// readRecordSetTTL Get the TTL to keep in the state: the configured one when all the records have it,
+// otherwise the TTL of the first record which doesn't, so the difference shows as drift
+func readRecordSetTTL(ttl int, records []entities.GenericRecord) int {
+	for _, record := range records {
+		if !sameRecordSetTTL(ttl, record) {
+			if record.TTL <= 0 {
+				return -1
+			}
+			return record.TTL
+		}
+	}
+	return ttl
+}
@@ -0,0 +1,90 @@
+package bluecat
+
+import (
+	"reflect"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestNormalizeRecordSetValue(t *testing.T) {
+	cases := []struct {
+		typerr string
+		first  string
+		second string
+	}{
+		{"AAAA", "2001:DB8:0::1", "2001:db8::1"},
+		{"MX", "10  mail.example.com.", "10 mail.example.com"},
+		{"TXT", `"v=spf1 -all"`, "v=spf1 -all"},
+		{"CAA", `0 ISSUE "letsencrypt.org"`, `0 issue "letsencrypt.org"`},
+	}
+	for _, c := range cases {
+		if normalizeRecordSetValue(c.typerr, c.first) != normalizeRecordSetValue(c.typerr, c.second) {
+			t.Errorf("expected %s values %q and %q to be the same", c.typerr, c.first, c.second)
+		}
+	}
+	if normalizeRecordSetValue("A", "10.0.0.1") == normalizeRecordSetValue("A", "10.0.0.10") {
+		t.Error("expected 10.0.0.1 and 10.0.0.10 to differ")
+	}
+}
+
+func TestPlanRecordSet(t *testing.T) {
+	existing := []entities.GenericRecord{
+		{BAMId: 1, TypeRR: "A", Data: "10.0.0.1"},
+		{BAMId: 2, TypeRR: "A", Data: "10.0.0.2"},
+		{BAMId: 3, TypeRR: "A", Data: "10.0.0.9"},
+		{BAMId: 4, TypeRR: "A", Data: "10.0.0.1"},
+	}
+	create, remove := planRecordSet("A", []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, -1, existing)
+	if !reflect.DeepEqual(create, []string{"10.0.0.3"}) {
+		t.Fatalf("expected to create 10.0.0.3, got %v", create)
+	}
+	removed := make([]int, 0)
+	for _, record := range remove {
+		removed = append(removed, record.BAMId)
+	}
+	// the extra value and the duplicate are deleted
+	if !reflect.DeepEqual(removed, []int{3, 4}) {
+		t.Fatalf("expected to delete the records 3 and 4, got %v", removed)
+	}
+}
+
+func TestPlanRecordSetReplacesWrongTTL(t *testing.T) {
+	existing := []entities.GenericRecord{
+		{BAMId: 1, TypeRR: "A", Data: "10.0.0.1", TTL: 300},
+		{BAMId: 2, TypeRR: "A", Data: "10.0.0.2", TTL: 600},
+	}
+	create, remove := planRecordSet("A", []string{"10.0.0.1", "10.0.0.2"}, 300, existing)
+	if !reflect.DeepEqual(create, []string{"10.0.0.2"}) || len(remove) != 1 || remove[0].BAMId != 2 {
+		t.Fatalf("expected to replace 10.0.0.2, got create %v and remove %v", create, remove)
+	}
+	if ttl := readRecordSetTTL(300, existing); ttl != 600 {
+		t.Fatalf("expected the drifted TTL 600, got %d", ttl)
+	}
+	if ttl := readRecordSetTTL(-1, []entities.GenericRecord{{Data: "10.0.0.1"}}); ttl != -1 {
+		t.Fatalf("expected the TTL of the zone, got %d", ttl)
+	}
+}
+
+func TestReadRecordSetValuesKeepsConfiguredForm(t *testing.T) {
+	records := []entities.GenericRecord{
+		{BAMId: 1, TypeRR: "MX", Data: "10 mail.example.com"},
+		{BAMId: 2, TypeRR: "MX", Data: "20 backup.example.com"},
+	}
+	got := readRecordSetValues("MX", []string{"10 mail.example.com."}, records)
+	expected := []string{"10 mail.example.com.", "20 backup.example.com"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRecordSetParseId(t *testing.T) {
+	name, typerr, err := recordSetParseId("api.example.com/a")
+	if err != nil || name != "api.example.com" || typerr != "A" {
+		t.Fatalf("expected (api.example.com, A), got (%s, %s, %v)", name, typerr, err)
+	}
+	for _, id := range []string{"api.example.com", "api/A", "api.example.com/"} {
+		if _, _, err := recordSetParseId(id); err == nil {
+			t.Errorf("expected an error for the ID %q", id)
+		}
+	}
+}
@@ -47,7 +47,7 @@ func ResourceBlock() *schema.Resource {
 		Read:   getBlock,
 		Update: updateBlock,
 		Delete: deleteBlock,
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration"), func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			// Next-available mode resolves address/cidr during Create, so mark as computed at plan time.
 			address := d.Get("address").(string)
 			cidr := d.Get("cidr").(string)
@@ -58,12 +58,13 @@ func ResourceBlock() *schema.Resource {
 				d.SetNewComputed("cidr")
 			}
 			return nil
-		},
+		}),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the Block in the default Configuration if doesn't specify",
 			},
 			"name": {
@@ -107,6 +108,12 @@ func ResourceBlock() *schema.Resource {
 				Description: "The deployment options for the block.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"ignore_unmanaged_options": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not to ignore the deployment options on all the servers which are not in deployment_options, instead of showing them as drift",
+			},
 			"properties": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -121,6 +128,23 @@ func ResourceBlock() *schema.Resource {
 				Description: "Block IP version: ipv4 or ipv6",
 				Default:     "ipv4",
 			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the Block to the DHCP servers",
+				Default:     "no",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the Block",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			State: resourceImporter,
@@ -130,6 +154,7 @@ func ResourceBlock() *schema.Resource {
 
 // createIP4Block Create the new IPv4 Block
 func createBlock(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 	log.Debugf("Beginning to create Block %s", d.Get("address"))
 
 	block := entities.Block{}
@@ -217,6 +242,8 @@ func createBlock(d *schema.ResourceData, m interface{}) error {
 		log.Error(msg)
 		return fmt.Errorf(msg)
 	}
+	// save the block right away: a failure below leaves it tainted in the state
+	d.SetId(block.AddressCIDR())
 	err := utils.CreateDeploymentOptions(objMgr, entities.DeploymentOption{
 		Configuration: block.Configuration,
 		ResourceType:  "block",
@@ -226,11 +253,22 @@ func createBlock(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("creating deployment options on Block (%s) failed: %w", block.AddressCIDR(), err)
 	}
+	err = deployDHCPObject(d, objMgr, block.IPVersion, fmt.Sprintf("Block %s", block.AddressCIDR()), func() (int, error) {
+		blockEntity, err := objMgr.GetBlock(block.Configuration, block.Address, block.CIDR, block.IPVersion)
+		if err != nil {
+			return 0, err
+		}
+		return blockEntity.BlockId, nil
+	})
+	if err != nil {
+		return err
+	}
 	return getBlock(d, m)
 }
 
 // getBlock Get the IPv4 Block
 func getBlock(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 	var address, cidrStr string
 	var err error
 	if d.Id() != "" {
@@ -281,12 +319,12 @@ func getBlock(d *schema.ResourceData, m interface{}) error {
 
 	// --- Filter server properties using keys from config ---
 	filteredProperties := utils.FilterProperties(bamProps, cfgProps)
-	deploymentOptions, err := utils.ReadDeploymentOptions(objMgr, entities.DeploymentOption{
+	deploymentOptions, err := utils.ListDeploymentOptions(objMgr, entities.DeploymentOption{
 		Configuration: configuration,
 		ResourceType:  "block",
 		ResourceRef:   block.AddressCIDR(),
 		IPVersion:     block.IPVersion,
-	}, utils.ExpandStringMap(d.Get("deployment_options")))
+	}, utils.ExpandStringMap(d.Get("deployment_options")), d.Get("ignore_unmanaged_options").(bool))
 	if err != nil {
 		return fmt.Errorf("getting deployment options on Block %s failed: %w", block.AddressCIDR(), err)
 	}
@@ -339,6 +377,16 @@ func updateBlock(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("updating deployment options on Block (%s) failed: %w", block.AddressCIDR(), err)
 	}
+	err = deployDHCPObject(d, objMgr, block.IPVersion, fmt.Sprintf("Block %s", block.AddressCIDR()), func() (int, error) {
+		blockEntity, err := objMgr.GetBlock(block.Configuration, block.Address, block.CIDR, block.IPVersion)
+		if err != nil {
+			return 0, err
+		}
+		return blockEntity.BlockId, nil
+	})
+	if err != nil {
+		return err
+	}
 	log.Debugf("Completed to update Block %s", d.Get("address"))
 	return getBlock(d, m)
 }
@@ -12,25 +12,29 @@ import (
 // ResourceCNAMERecord The CNAME record
 func ResourceCNAMERecord() *schema.Resource {
 	return &schema.Resource{
-		Create: createCNAMERecord,
-		Read:   getCNAMERecord,
-		Update: updateCNAMERecord,
-		Delete: deleteCNAMERecord,
+		Create:        createCNAMERecord,
+		Read:          getCNAMERecord,
+		Update:        updateCNAMERecord,
+		Delete:        deleteCNAMERecord,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("absolute_name")),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the CNAME record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
 			},
 			"zone": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Zone in which you want to update a CNAME record. If not provided, the absolute name must be FQDN ones",
 			},
 			"absolute_name": {
@@ -71,6 +75,11 @@ func ResourceCNAMERecord() *schema.Resource {
 				Description: "Whether or not to selectively deploy the CNAME record",
 				Default:     "no",
 			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the CNAME record",
+			},
 			"batch_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -91,6 +100,8 @@ func ResourceCNAMERecord() *schema.Resource {
 
 // createCNAMERecord Create the new CNAME record
 func createCNAMERecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
 	log.Debugf("Beginning to create CNAME record %s", d.Get("absolute_name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -118,25 +129,28 @@ func createCNAMERecord(d *schema.ResourceData, m interface{}) error {
 		log.Debug(msg)
 		return fmt.Errorf(msg)
 	}
+	d.SetId(fqdnName)
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", cnameRecord.BAMId)
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		cnameRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{cnameRecord.BAMId}, cnameRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{cnameRecord.BAMId}, cnameRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying CNAME record %s: %s", fqdnName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
-	d.Set("absolute_name", fqdnName)
-	d.Set("bam_id", cnameRecord.BAMId)
 	log.Debugf("Completed to create CNAME record %s", d.Get("absolute_name"))
 	return getCNAMERecord(d, m)
 }
 
 // getCNAMERecord Get the CNAME record
 func getCNAMERecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get CNAME record: %s", d.Get("absolute_name"))
 	absoluteName, err := getAbsoluteName(d)
 	configuration := d.Get("configuration").(string)
@@ -213,13 +227,14 @@ func updateCNAMERecord(d *schema.ResourceData, m interface{}) error {
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		cnameRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{cnameRecord.BAMId}, cnameRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{cnameRecord.BAMId}, cnameRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying CNAME record %s: %s", fqdnName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
 	d.Set("absolute_name", fqdnName)
 	d.Set("bam_id", cnameRecord.BAMId)
@@ -247,7 +262,7 @@ func deleteCNAMERecord(d *schema.ResourceData, m interface{}) error {
 	}
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
-		res, err := objMgr.Connector.DeployObject([]int{bamID}, d.Get("batch_mode").(string))
+		res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying CNAME record %s: %s", absoluteName, err)
 			log.Debug(msg)
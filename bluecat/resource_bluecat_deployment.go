@@ -0,0 +1,98 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDeployment Deploys the objects queued while deferred_deployment is enabled
+func ResourceDeployment() *schema.Resource {
+
+	return &schema.Resource{
+		Create: createDeployment,
+		Read:   getDeployment,
+		Update: updateDeployment,
+		Delete: deleteDeployment,
+
+		// the queue is filled during the apply, so the deployment is planned on every apply
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if d.Id() == "" {
+				return nil
+			}
+			if err := d.SetNewComputed("deployed_ids"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("last_deployment_status")
+		},
+
+		Schema: map[string]*schema.Schema{
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary values kept in the state. The queued objects are deployed on every apply whether or not they change",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last deployment",
+			},
+			"deployed_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The BAM IDs of the objects sent in the last deployment",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+// createDeployment Deploy all the queued objects
+func createDeployment(d *schema.ResourceData, m interface{}) error {
+	if err := flushDeployment(d, m); err != nil {
+		return err
+	}
+	d.SetId(id.UniqueId())
+	return getDeployment(d, m)
+}
+
+// updateDeployment Deploy the objects queued by this apply
+func updateDeployment(d *schema.ResourceData, m interface{}) error {
+	if err := flushDeployment(d, m); err != nil {
+		return err
+	}
+	return getDeployment(d, m)
+}
+
+// flushDeployment Deploy all the queued objects and keep what was sent
+func flushDeployment(d *schema.ResourceData, m interface{}) error {
+	log.Debugf("Beginning to deploy the queued objects")
+	objMgr := GetObjManager(m)
+
+	deployedIDs, status, err := objMgr.FlushDeploymentQueue()
+	d.Set("deployed_ids", deployedIDs)
+	d.Set("last_deployment_status", status)
+	if err != nil {
+		msg := fmt.Sprintf("Error deploying the queued objects: %s", err)
+		log.Error(msg)
+		return fmt.Errorf(msg)
+	}
+	log.Debugf("Completed to deploy %d queued objects", len(deployedIDs))
+	return nil
+}
+
+// getDeployment The deployment only lives in the state, nothing to read back from BAM
+func getDeployment(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+// deleteDeployment Remove the deployment from the state, the deployed objects are not affected
+func deleteDeployment(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
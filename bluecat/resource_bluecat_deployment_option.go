@@ -0,0 +1,351 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deploymentOptionTargets The kinds of object a deployment option can be put on
+var deploymentOptionTargets = []string{"zone", "view", "network", "block", "ip_address"}
+
+// deploymentOptionTypes The types of deployment option
+var deploymentOptionTypes = []string{"dns", "dhcp_client", "dhcp_service"}
+
+// ResourceDeploymentOption The deployment option on a zone, view, network, block or IP address, for all the servers or one of them
+func ResourceDeploymentOption() *schema.Resource {
+	return &schema.Resource{
+		Create:        createDeploymentOption,
+		Read:          getDeploymentOption,
+		Update:        updateDeploymentOption,
+		Delete:        deleteDeploymentOption,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Configuration. Using the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The view of the zone, or the view the option is put on if no zone, network, block or IP address is provided",
+			},
+			"zone": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The zone the option is put on",
+				ConflictsWith: []string{"network", "block", "ip_address"},
+			},
+			"network": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The CIDR of the IPv4 or IPv6 network the option is put on",
+				ConflictsWith: []string{"zone", "block", "ip_address"},
+			},
+			"block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The CIDR of the IPv4 or IPv6 block the option is put on",
+				ConflictsWith: []string{"zone", "network", "ip_address"},
+			},
+			"ip_address": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The IPv4 or IPv6 address the option is put on",
+				ConflictsWith: []string{"zone", "network", "block"},
+			},
+			"option_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "dns",
+				Description: "The type of the option: dns, dhcp_client or dhcp_service. The DHCP options are only on networks, blocks and IP addresses",
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					optionType := strings.ToLower(v.(string))
+					for _, known := range deploymentOptionTypes {
+						if optionType == known {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%s must be one of %s, got %q", k, strings.Join(deploymentOptionTypes, ", "), v.(string)))
+					return
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the option, such as allow-query, router or default-lease-time",
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The value of the option",
+				ExactlyOneOf: []string{"value", "values"},
+			},
+			"values": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "The values of a multi-valued option, such as the addresses of the router DHCP option. They are joined with commas",
+				ExactlyOneOf: []string{"value", "values"},
+			},
+			"server_fqdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The FQDN of the server the option is scoped to. If not provided, the option applies to all the servers",
+			},
+			"properties": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(v interface{}) string {
+					return utils.JoinProperties(utils.ParseProperties(v.(string)))
+				},
+				DiffSuppressFunc: suppressWhenRemoteHasSuperset,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: deploymentOptionImporter,
+		},
+	}
+}
+
+// deploymentOptionImporter Import the deployment option by its ID, the kind of object, the object, the option type,
+// the option name and the server FQDN joined by colons, such as zone:example.com:dns:allow-query: for all the servers
+// or network:10.0.0.0/24:dhcp_client:router:bdds1.example.com
+func deploymentOptionImporter(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	target, ref, optionType, name, serverFQDN, err := deploymentOptionParseId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set(target, ref)
+	d.Set("option_type", optionType)
+	d.Set("name", name)
+	d.Set("server_fqdn", serverFQDN)
+	return []*schema.ResourceData{d}, nil
+}
+
+func deploymentOptionParseId(id string) (string, string, string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) < 5 {
+		return "", "", "", "", "", fmt.Errorf("unexpected format of deployment option ID (%s), expected target:name:option_type:option_name:server_fqdn", id)
+	}
+	target := strings.ToLower(parts[0])
+	ref := strings.Join(parts[1:len(parts)-3], ":")
+	optionType := strings.ToLower(parts[len(parts)-3])
+	name := parts[len(parts)-2]
+	serverFQDN := parts[len(parts)-1]
+	if ref == "" || name == "" {
+		return "", "", "", "", "", fmt.Errorf("unexpected format of deployment option ID (%s), expected target:name:option_type:option_name:server_fqdn", id)
+	}
+	knownType := false
+	for _, known := range deploymentOptionTypes {
+		knownType = knownType || optionType == known
+	}
+	if !knownType {
+		return "", "", "", "", "", fmt.Errorf("unexpected option type %q in the deployment option ID (%s), expected one of %s", optionType, id, strings.Join(deploymentOptionTypes, ", "))
+	}
+	for _, known := range deploymentOptionTargets {
+		if target == known {
+			return target, ref, optionType, name, serverFQDN, nil
+		}
+	}
+	return "", "", "", "", "", fmt.Errorf("unexpected target %q in the deployment option ID (%s), expected one of %s", target, id, strings.Join(deploymentOptionTargets, ", "))
+}
+
+// getDeploymentOptionTarget Get the deployment option entity pointing to the zone, view, network, block or IP address, and the ID of the resource
+func getDeploymentOptionTarget(d *schema.ResourceData) (entities.DeploymentOption, string) {
+	target := entities.DeploymentOption{
+		Configuration: d.Get("configuration").(string),
+		View:          d.Get("view").(string),
+		Name:          d.Get("name").(string),
+		OptionType:    strings.ToLower(d.Get("option_type").(string)),
+		ServerID:      utils.DeploymentOptionAllServersID,
+	}
+	kind, ref := "view", target.View
+	if zone := d.Get("zone").(string); zone != "" {
+		kind, ref = "zone", zone
+		target.Zone = zone
+	}
+	for _, resourceType := range []string{"network", "block", "ip_address"} {
+		if value := d.Get(resourceType).(string); value != "" {
+			kind, ref = resourceType, value
+			target.View = ""
+			target.ResourceType = resourceType
+			target.ResourceRef = value
+			target.IPVersion = "ipv4"
+			if strings.Contains(value, ":") {
+				target.IPVersion = "ipv6"
+			}
+		}
+	}
+	return target, fmt.Sprintf("%s:%s:%s:%s:%s", kind, ref, target.OptionType, target.Name, d.Get("server_fqdn").(string))
+}
+
+// getDeploymentOptionValue Get the value of the option, the values of a multi-valued option being joined with commas
+func getDeploymentOptionValue(d *schema.ResourceData) string {
+	values, ok := d.GetOk("values")
+	if !ok {
+		return d.Get("value").(string)
+	}
+	result := make([]string, 0)
+	for _, value := range values.([]interface{}) {
+		result = append(result, strings.TrimSpace(value.(string)))
+	}
+	return strings.Join(result, ",")
+}
+
+// splitDeploymentOptionValue Get the values of a multi-valued option
+func splitDeploymentOptionValue(value string) []string {
+	result := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// resolveDeploymentOptionServer Check the option type against the target and point the option to the server of server_fqdn
+func resolveDeploymentOptionServer(d *schema.ResourceData, objMgr *utils.ObjectManager, target *entities.DeploymentOption) error {
+	if target.OptionType != "dns" && target.ResourceType == "" {
+		return fmt.Errorf("the DHCP deployment options can only be put on networks, blocks and IP addresses")
+	}
+	serverFQDN := d.Get("server_fqdn").(string)
+	if serverFQDN == "" {
+		return nil
+	}
+	server, err := objMgr.GetServerByFQDN(target.Configuration, serverFQDN)
+	if err != nil {
+		return fmt.Errorf("Getting the server %s failed: %w", serverFQDN, err)
+	}
+	target.ServerID = server.ServerId
+	return nil
+}
+
+// createDeploymentOption Create the new deployment option
+func createDeploymentOption(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	target, id := getDeploymentOptionTarget(d)
+	log.Debugf("Beginning to create the deployment option %s", id)
+
+	objMgr := GetObjManager(m)
+
+	if err := resolveDeploymentOptionServer(d, objMgr, &target); err != nil {
+		return err
+	}
+	target.Value = getDeploymentOptionValue(d)
+	target.Properties = d.Get("properties").(string)
+	if _, err := objMgr.CreateDeploymentOption(target); err != nil {
+		msg := fmt.Sprintf("Error creating the deployment option %s: %s", id, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	d.SetId(id)
+	log.Debugf("Completed to create the deployment option %s", id)
+	return getDeploymentOption(d, m)
+}
+
+// getDeploymentOption Get the deployment option
+func getDeploymentOption(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	target, id := getDeploymentOptionTarget(d)
+	log.Debugf("Beginning to get the deployment option %s", id)
+
+	objMgr := GetObjManager(m)
+
+	err := resolveDeploymentOptionServer(d, objMgr, &target)
+	if err == nil {
+		var option *entities.DeploymentOption
+		option, err = objMgr.GetDeploymentOption(target)
+		if err == nil {
+			target.Value = option.Value
+			target.Properties = option.Properties
+		}
+	}
+	if err != nil {
+		if utils.IsNotFoundErr(err) && d.Id() != "" {
+			log.Warnf("Deployment option %q not found; removing from state to trigger recreation", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting the deployment option %s failed: %w", id, err)
+	}
+
+	bamProps := utils.ParseProperties(target.Properties)
+	cfgProps := utils.ParseProperties(d.Get("properties").(string))
+
+	d.SetId(id)
+	d.Set("value", target.Value)
+	if _, ok := d.GetOk("values"); ok {
+		d.Set("values", splitDeploymentOptionValue(target.Value))
+	}
+	d.Set("properties", utils.JoinProperties(utils.FilterProperties(bamProps, cfgProps)))
+	log.Debugf("Completed reading the deployment option %s", id)
+	return nil
+}
+
+// updateDeploymentOption Update the value and the properties of the deployment option
+func updateDeploymentOption(d *schema.ResourceData, m interface{}) error {
+	target, id := getDeploymentOptionTarget(d)
+	log.Debugf("Beginning to update the deployment option %s", id)
+
+	objMgr := GetObjManager(m)
+
+	if err := resolveDeploymentOptionServer(d, objMgr, &target); err != nil {
+		return err
+	}
+	target.Value = getDeploymentOptionValue(d)
+	target.Properties = d.Get("properties").(string)
+	if _, err := objMgr.UpdateDeploymentOption(target); err != nil {
+		msg := fmt.Sprintf("Error updating the deployment option %s: %s", id, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	log.Debugf("Completed to update the deployment option %s", id)
+	return getDeploymentOption(d, m)
+}
+
+// deleteDeploymentOption Delete the deployment option
+func deleteDeploymentOption(d *schema.ResourceData, m interface{}) error {
+	target, id := getDeploymentOptionTarget(d)
+	log.Debugf("Beginning to delete the deployment option %s", id)
+
+	objMgr := GetObjManager(m)
+
+	if err := resolveDeploymentOptionServer(d, objMgr, &target); err != nil {
+		if utils.IsNotFoundErr(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if _, err := objMgr.DeleteDeploymentOption(target); err != nil && !utils.IsNotFoundErr(err) {
+		msg := fmt.Sprintf("Error deleting the deployment option %s: %s", id, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	d.SetId("")
+	log.Debugf("Completed to delete the deployment option %s", id)
+	return nil
+}
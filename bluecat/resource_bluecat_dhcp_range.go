@@ -15,15 +15,17 @@ import (
 func ResourceDHCPRange() *schema.Resource {
 
 	return &schema.Resource{
-		Create: createDHCPRange,
-		Read:   getDHCPRange,
-		Update: updateDHCPRange,
-		Delete: deleteDHCPRange,
+		Create:        createDHCPRange,
+		Read:          getDHCPRange,
+		Update:        updateDHCPRange,
+		Delete:        deleteDHCPRange,
+		CustomizeDiff: setProviderDefaultsDiff("configuration"),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the Network in the default Configuration if doesn't specify",
 			},
 			"network": {
@@ -64,12 +66,30 @@ func ResourceDHCPRange() *schema.Resource {
 				Optional:    true,
 				Description: "DHCPRange's IP version",
 			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the DHCP Range to the DHCP servers",
+				Default:     "no",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the DHCP Range",
+			},
 		},
 	}
 }
 
 // createDHCPRange Create the new DHCP Range
 func createDHCPRange(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 
 	objMgr := GetObjManager(m)
 
@@ -91,12 +111,21 @@ func createDHCPRange(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Debugf("Successful to create DHCP Range (%s - %s) in the network %s", dhcpRange.Start, dhcpRange.End, dhcpRange.Network)
+	d.SetId(dhcpRange.Start + "-" + dhcpRange.End)
+
+	err = deployDHCPObject(d, objMgr, dhcpRange.IPVersion, fmt.Sprintf("DHCP Range (%s - %s)", dhcpRange.Start, dhcpRange.End), func() (int, error) {
+		return getDHCPRangeBAMID(objMgr, dhcpRange)
+	})
+	if err != nil {
+		return err
+	}
 
 	return getDHCPRange(d, m)
 }
 
 // getDHCPRange Get the DHCP Range
 func getDHCPRange(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 
 	objMgr := GetObjManager(m)
 
@@ -110,6 +139,11 @@ func getDHCPRange(d *schema.ResourceData, m interface{}) error {
 
 	dhcpRangeEntity, err := objMgr.GetDHCPRange(dhcpRange)
 	if err != nil {
+		if utils.IsNotFoundErr(err) && d.Id() != "" {
+			log.Warnf("DHCP Range %q not found; removing from state to trigger recreation", d.Id())
+			d.SetId("")
+			return nil
+		}
 		msg := fmt.Sprintf("Getting DHCP Range (%s - %s) failed: %s", dhcpRangeEntity.Start, dhcpRangeEntity.End, err)
 		msg += fmt.Sprintf("Subpath: %s", dhcpRangeEntity.SubPath())
 		log.Error(msg)
@@ -166,6 +200,13 @@ func updateDHCPRange(d *schema.ResourceData, m interface{}) error {
 	d.Set("start", dhcpRange.Start)
 	d.Set("end", dhcpRange.End)
 
+	err = deployDHCPObject(d, objMgr, dhcpRange.IPVersion, fmt.Sprintf("DHCP Range (%s - %s)", dhcpRange.Start, dhcpRange.End), func() (int, error) {
+		return getDHCPRangeBAMID(objMgr, dhcpRange)
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Debugf("Completed to update DHCP Range (%s - %s)", dhcpRange.Start, dhcpRange.End)
 	return getDHCPRange(d, m)
 }
@@ -183,17 +224,42 @@ func deleteDHCPRange(d *schema.ResourceData, m interface{}) error {
 
 	log.Debugf("Beginning to delete DHCP Range (%s - %s)", dhcpRange.Start, dhcpRange.End)
 
+	// A range already deleted, e.g. by a previous attempt whose deployment failed, only needs the deployment
 	_, err := objMgr.DeleteDHCPRange(dhcpRange)
-	if err != nil {
+	if err != nil && !utils.IsNotFoundErr(err) {
 		msg := fmt.Sprintf("Delete DHCP Range (%s - %s) failed: %s", dhcpRange.Start, dhcpRange.End, err)
 		log.Error(msg)
 		return fmt.Errorf(msg)
 	}
+	// The range is gone, deploying its network removes it from the DHCP servers
+	err = deployDHCPObject(d, objMgr, dhcpRange.IPVersion, fmt.Sprintf("the network %s of the deleted DHCP Range", dhcpRange.Network), func() (int, error) {
+		network, err := objMgr.GetNetwork(&entities.Network{
+			Configuration: dhcpRange.Configuration,
+			CIDR:          dhcpRange.Network,
+			IPVersion:     dhcpRange.IPVersion,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return network.NetWorkId, nil
+	})
+	if err != nil {
+		return err
+	}
 	d.SetId("")
 	log.Debugf("Deletion of DHCP Range complete ")
 	return nil
 }
 
+// getDHCPRangeBAMID Get the BAM ID of the DHCP Range
+func getDHCPRangeBAMID(objMgr *utils.ObjectManager, dhcpRange entities.DHCPRange) (int, error) {
+	dhcpRangeEntity, err := objMgr.GetDHCPRange(dhcpRange)
+	if err != nil {
+		return 0, err
+	}
+	return dhcpRangeEntity.BAMId, nil
+}
+
 func getAttributeFromProperties(attributeName string, props string) string {
 	listProperties := strings.Split(props, "|")
 	fmt.Println(listProperties)
@@ -0,0 +1,485 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dhcpReservationTypedOptions The DHCP options of the reservation having their own attribute: "attribute": {"option type", "option name"}
+var dhcpReservationTypedOptions = map[string][2]string{
+	"hostname":    {"dhcp_client", "host-name"},
+	"next_server": {"dhcp_service", "next-server"},
+	"boot_file":   {"dhcp_service", "filename"},
+}
+
+// ResourceDHCPReservation The DHCP reservation of a MAC address in a network
+func ResourceDHCPReservation() *schema.Resource {
+	return &schema.Resource{
+		Create:        createDHCPReservation,
+		Read:          getDHCPReservation,
+		Update:        updateDHCPReservation,
+		Delete:        deleteDHCPReservation,
+		CustomizeDiff: setProviderDefaultsDiff("configuration"),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Configuration. Reserving the IP address in the default Configuration if doesn't specify",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Network address in CIDR format",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The MAC address the IP address is reserved for",
+				StateFunc: func(v interface{}) string {
+					return normalizeMACAddress(v.(string))
+				},
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if normalizeMACAddress(v.(string)) == "" {
+						errs = append(errs, fmt.Errorf("%s must be a MAC address, got %q", k, v.(string)))
+					}
+					return
+				},
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The reserved IPv4/IPv6 address. If not provided, the next available IP address of the network is reserved",
+			},
+			"ip_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "IP Address version: ipv4 or ipv6. Detected from the network if not provided",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the reserved IP address",
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The host-name DHCP client option given to the client",
+			},
+			"next_server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The next-server DHCP service option: the server the client boots from",
+			},
+			"boot_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The filename DHCP service option: the boot file of the client",
+			},
+			"client_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The other DHCP client options of the reservation, as a map of option name to value. The values of multi-valued options are joined with commas",
+			},
+			"service_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The other DHCP service options of the reservation, as a map of option name to value",
+			},
+			"properties": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(v interface{}) string {
+					return utils.JoinProperties(utils.ParseProperties(v.(string)))
+				},
+				DiffSuppressFunc: suppressWhenRemoteHasSuperset,
+			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the reservation to the DHCP servers",
+				Default:     "no",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the reservation",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: dhcpReservationImporter,
+		},
+	}
+}
+
+// normalizeMACAddress Get the MAC address as lower case pairs joined by colons, empty if it isn't a MAC address
+func normalizeMACAddress(mac string) string {
+	hardwareAddr, err := net.ParseMAC(strings.TrimSpace(mac))
+	if err != nil {
+		digits := strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.TrimSpace(mac))
+		if len(digits) != 12 {
+			return ""
+		}
+		pairs := make([]string, 0, 6)
+		for i := 0; i < 12; i += 2 {
+			pairs = append(pairs, digits[i:i+2])
+		}
+		if hardwareAddr, err = net.ParseMAC(strings.Join(pairs, ":")); err != nil {
+			return ""
+		}
+	}
+	if len(hardwareAddr) != 6 {
+		return ""
+	}
+	return hardwareAddr.String()
+}
+
+// dhcpReservationImporter Import the DHCP reservation by its ID, the network and the MAC address joined by a slash,
+// such as 10.0.0.0/24/00:11:22:33:44:55
+func dhcpReservationImporter(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	network, mac, err := dhcpReservationParseId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("network", network)
+	d.Set("mac_address", mac)
+	d.SetId(fmt.Sprintf("%s/%s", network, mac))
+	return []*schema.ResourceData{d}, nil
+}
+
+func dhcpReservationParseId(id string) (string, string, error) {
+	index := strings.LastIndex(id, "/")
+	if index <= 0 {
+		return "", "", fmt.Errorf("unexpected format of DHCP reservation ID (%s), expected network/mac_address", id)
+	}
+	network := id[:index]
+	mac := normalizeMACAddress(id[index+1:])
+	if _, _, err := net.ParseCIDR(network); err != nil || mac == "" {
+		return "", "", fmt.Errorf("unexpected format of DHCP reservation ID (%s), expected network/mac_address", id)
+	}
+	return network, mac, nil
+}
+
+// getDHCPReservationOptions Get the DHCP client and service options of the reservation, the typed ones included
+func getDHCPReservationOptions(d *schema.ResourceData) (map[string]string, map[string]string, error) {
+	options := map[string]map[string]string{
+		"dhcp_client":  utils.ExpandStringMap(d.Get("client_options")),
+		"dhcp_service": utils.ExpandStringMap(d.Get("service_options")),
+	}
+	for optionType := range options {
+		if options[optionType] == nil {
+			options[optionType] = make(map[string]string)
+		}
+	}
+	for _, attribute := range []string{"hostname", "next_server", "boot_file"} {
+		typed := dhcpReservationTypedOptions[attribute]
+		value := d.Get(attribute).(string)
+		if value == "" {
+			continue
+		}
+		if _, ok := options[typed[0]][typed[1]]; ok {
+			return nil, nil, fmt.Errorf("the %s option is set both by %s and in the %s options", typed[1], attribute, strings.TrimPrefix(typed[0], "dhcp_"))
+		}
+		options[typed[0]][typed[1]] = value
+	}
+	return options["dhcp_client"], options["dhcp_service"], nil
+}
+
+// getDHCPReservationOptionTarget Get the deployment option entity pointing to the reserved IP address
+func getDHCPReservationOptionTarget(d *schema.ResourceData, optionType string) entities.DeploymentOption {
+	return entities.DeploymentOption{
+		Configuration: d.Get("configuration").(string),
+		ResourceType:  "ip_address",
+		ResourceRef:   d.Get("ip_address").(string),
+		IPVersion:     d.Get("ip_version").(string),
+		OptionType:    optionType,
+	}
+}
+
+// updateDHCPReservationOptions Create, update and delete the DHCP options of the reservation
+func updateDHCPReservationOptions(d *schema.ResourceData, objMgr *utils.ObjectManager, oldClient, oldService, newClient, newService map[string]string) error {
+	err := utils.UpdateDeploymentOptionsForTarget(objMgr, getDHCPReservationOptionTarget(d, "dhcp_client"), oldClient, newClient)
+	if err == nil {
+		err = utils.UpdateDeploymentOptionsForTarget(objMgr, getDHCPReservationOptionTarget(d, "dhcp_service"), oldService, newService)
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Error setting the DHCP options of the reservation %s: %s", d.Get("ip_address"), err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	return nil
+}
+
+// deployDHCPReservation Deploy the reservation to the DHCP servers
+func deployDHCPReservation(d *schema.ResourceData, objMgr *utils.ObjectManager) error {
+	configuration := d.Get("configuration").(string)
+	ipAddress := d.Get("ip_address").(string)
+	ipVersion := d.Get("ip_version").(string)
+	return deployDHCPObject(d, objMgr, ipVersion, fmt.Sprintf("DHCP reservation %s", ipAddress), func() (int, error) {
+		address, err := objMgr.GetIPAddress(configuration, ipAddress, ipVersion)
+		if err != nil {
+			return 0, err
+		}
+		return address.BAMId, nil
+	})
+}
+
+// createDHCPReservation Reserve the IP address for the MAC address and set its DHCP options
+func createDHCPReservation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
+	network := d.Get("network").(string)
+	mac := normalizeMACAddress(d.Get("mac_address").(string))
+	log.Debugf("Beginning to reserve an IP address for %s in the network %s", mac, network)
+
+	clientOptions, serviceOptions, err := getDHCPReservationOptions(d)
+	if err != nil {
+		return err
+	}
+	ipVersion := getIpVersion(d, strings.Split(network, "/")[0])
+	d.Set("ip_version", ipVersion)
+
+	objMgr := GetObjManager(m)
+
+	address := entities.IPAddress{
+		Configuration: d.Get("configuration").(string),
+		Address:       d.Get("ip_address").(string),
+		Mac:           mac,
+		Name:          d.Get("name").(string),
+		Action:        entities.AllocateDHCPReserved,
+		Properties:    d.Get("properties").(string),
+		IPVersion:     ipVersion,
+	}
+	if address.Address == "" {
+		address.CIDR = strings.Split(network, "/")[0]
+	}
+	reserved, err := objMgr.CreateIPAddress(address)
+	if err != nil {
+		msg := fmt.Sprintf("Error reserving an IP address for %s in the network %s: %s", mac, network, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	if address.Address == "" {
+		address.Address = reserved.Address
+	}
+	d.Set("ip_address", address.Address)
+
+	if err := updateDHCPReservationOptions(d, objMgr, map[string]string{}, map[string]string{}, clientOptions, serviceOptions); err != nil {
+		// release the address rather than leaving a reservation without its options
+		if _, rollbackErr := objMgr.DeleteIPAddress(address.Configuration, address.Address, ipVersion); rollbackErr != nil {
+			log.Errorf("Releasing the IP address %s failed: %s", address.Address, rollbackErr)
+			d.SetId(fmt.Sprintf("%s/%s", network, mac))
+		}
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", network, mac))
+
+	if err := deployDHCPReservation(d, objMgr); err != nil {
+		return err
+	}
+	log.Debugf("Completed to reserve the IP address %s for %s", address.Address, mac)
+	return getDHCPReservation(d, m)
+}
+
+// getDHCPReservation Get the reserved IP address and its DHCP options
+func getDHCPReservation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
+	configuration := d.Get("configuration").(string)
+	network := d.Get("network").(string)
+	mac := normalizeMACAddress(d.Get("mac_address").(string))
+	ipVersion := d.Get("ip_version").(string)
+	if ipVersion == "" {
+		ipVersion = getIpVersion(d, strings.Split(network, "/")[0])
+	}
+	log.Debugf("Beginning to get the DHCP reservation %s", d.Id())
+
+	objMgr := GetObjManager(m)
+
+	var address *entities.IPAddress
+	var err error
+	if ipAddress := d.Get("ip_address").(string); ipAddress != "" {
+		address, err = objMgr.GetIPAddress(configuration, ipAddress, ipVersion)
+	} else {
+		// imported: only the network and the MAC address are known
+		address, err = objMgr.GetIPAddressByMAC(configuration, strings.Split(network, "/")[0], mac, ipVersion)
+	}
+	if err != nil {
+		if utils.IsNotFoundErr(err) && d.Id() != "" {
+			log.Warnf("DHCP reservation %q not found; removing from state to trigger recreation", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting the DHCP reservation %s failed: %w", d.Id(), err)
+	}
+	state := utils.GetPropertyValue("state", address.Properties)
+	if state != "" && state != "DHCP_RESERVED" && d.Id() != "" {
+		log.Warnf("The IP address %s of the DHCP reservation %q is %s; removing from state to trigger recreation", address.Address, d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+	if bamMAC := normalizeMACAddress(utils.GetPropertyValue("macAddress", address.Properties)); bamMAC != "" {
+		mac = bamMAC
+	}
+
+	d.Set("ip_address", address.Address)
+	d.Set("ip_version", ipVersion)
+	d.Set("mac_address", mac)
+	d.Set("name", address.Name)
+
+	clientOptions, err := utils.ListDeploymentOptions(objMgr, getDHCPReservationOptionTarget(d, "dhcp_client"), configuredDHCPReservationOptions(d, "dhcp_client", "client_options"), true)
+	if err != nil {
+		return fmt.Errorf("getting the DHCP client options of the reservation %s failed: %w", address.Address, err)
+	}
+	serviceOptions, err := utils.ListDeploymentOptions(objMgr, getDHCPReservationOptionTarget(d, "dhcp_service"), configuredDHCPReservationOptions(d, "dhcp_service", "service_options"), true)
+	if err != nil {
+		return fmt.Errorf("getting the DHCP service options of the reservation %s failed: %w", address.Address, err)
+	}
+	options := map[string]map[string]string{"dhcp_client": clientOptions, "dhcp_service": serviceOptions}
+	for attribute, typed := range dhcpReservationTypedOptions {
+		d.Set(attribute, options[typed[0]][typed[1]])
+		delete(options[typed[0]], typed[1])
+	}
+	d.Set("client_options", utils.FlattenStringMap(clientOptions))
+	d.Set("service_options", utils.FlattenStringMap(serviceOptions))
+
+	bamProps := utils.ParseProperties(address.Properties)
+	cfgProps := utils.ParseProperties(d.Get("properties").(string))
+	d.Set("properties", utils.JoinProperties(utils.FilterProperties(bamProps, cfgProps)))
+	d.SetId(fmt.Sprintf("%s/%s", network, mac))
+	log.Debugf("Completed reading the DHCP reservation %s", d.Id())
+	return nil
+}
+
+// configuredDHCPReservationOptions Get the names of the options of the type in the state, the typed ones included
+func configuredDHCPReservationOptions(d *schema.ResourceData, optionType string, attribute string) map[string]string {
+	configured := utils.ExpandStringMap(d.Get(attribute))
+	if configured == nil {
+		configured = make(map[string]string)
+	}
+	for typedAttribute, typed := range dhcpReservationTypedOptions {
+		if typed[0] == optionType && d.Get(typedAttribute).(string) != "" {
+			configured[typed[1]] = d.Get(typedAttribute).(string)
+		}
+	}
+	return configured
+}
+
+// updateDHCPReservation Update the MAC address, the name, the properties and the DHCP options of the reservation
+func updateDHCPReservation(d *schema.ResourceData, m interface{}) error {
+	network := d.Get("network").(string)
+	mac := normalizeMACAddress(d.Get("mac_address").(string))
+	log.Debugf("Beginning to update the DHCP reservation %s", d.Id())
+
+	objMgr := GetObjManager(m)
+
+	if d.HasChanges("mac_address", "name", "properties") {
+		address := entities.IPAddress{
+			Configuration: d.Get("configuration").(string),
+			Address:       d.Get("ip_address").(string),
+			Mac:           mac,
+			Name:          d.Get("name").(string),
+			IPVersion:     d.Get("ip_version").(string),
+		}
+		// 'address' and 'state' can not be changed, the MAC address has its own field
+		properties := d.Get("properties").(string)
+		for _, attribute := range []string{"address", "state", "macAddress"} {
+			properties = removeAttributeFromProperties(attribute, properties)
+		}
+		address.Properties = properties
+		if _, err := objMgr.UpdateIPAddress(address); err != nil {
+			msg := fmt.Sprintf("Error updating the DHCP reservation %s: %s", d.Id(), err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+	}
+
+	clientOptions, serviceOptions, err := getDHCPReservationOptions(d)
+	if err != nil {
+		return err
+	}
+	oldClient, oldService := configuredDHCPReservationOptionsBefore(d)
+	if err := updateDHCPReservationOptions(d, objMgr, oldClient, oldService, clientOptions, serviceOptions); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", network, mac))
+
+	if err := deployDHCPReservation(d, objMgr); err != nil {
+		return err
+	}
+	log.Debugf("Completed to update the DHCP reservation %s", d.Id())
+	return getDHCPReservation(d, m)
+}
+
+// configuredDHCPReservationOptionsBefore Get the DHCP client and service options of the reservation before the update
+func configuredDHCPReservationOptionsBefore(d *schema.ResourceData) (map[string]string, map[string]string) {
+	options := make(map[string]map[string]string)
+	for optionType, attribute := range map[string]string{"dhcp_client": "client_options", "dhcp_service": "service_options"} {
+		old, _ := d.GetChange(attribute)
+		options[optionType] = utils.ExpandStringMap(old)
+		if options[optionType] == nil {
+			options[optionType] = make(map[string]string)
+		}
+	}
+	for attribute, typed := range dhcpReservationTypedOptions {
+		if old, _ := d.GetChange(attribute); old.(string) != "" {
+			options[typed[0]][typed[1]] = old.(string)
+		}
+	}
+	return options["dhcp_client"], options["dhcp_service"]
+}
+
+// deleteDHCPReservation Release the reserved IP address, its DHCP options going along
+func deleteDHCPReservation(d *schema.ResourceData, m interface{}) error {
+	configuration := d.Get("configuration").(string)
+	network := d.Get("network").(string)
+	ipAddress := d.Get("ip_address").(string)
+	ipVersion := d.Get("ip_version").(string)
+	log.Debugf("Beginning to delete the DHCP reservation %s", d.Id())
+
+	objMgr := GetObjManager(m)
+
+	if _, err := objMgr.DeleteIPAddress(configuration, ipAddress, ipVersion); err != nil && !utils.IsNotFoundErr(err) {
+		msg := fmt.Sprintf("Delete IP address %s failed: %s", ipAddress, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	// The reservation is gone, deploying its network removes it from the DHCP servers
+	err := deployDHCPObject(d, objMgr, ipVersion, fmt.Sprintf("the network %s of the deleted DHCP reservation", network), func() (int, error) {
+		network, err := objMgr.GetNetwork(&entities.Network{
+			Configuration: configuration,
+			CIDR:          network,
+			IPVersion:     ipVersion,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return network.NetWorkId, nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	log.Debugf("Completed to delete the DHCP reservation")
+	return nil
+}
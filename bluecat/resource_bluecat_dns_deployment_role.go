@@ -0,0 +1,316 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deploymentRoleTargets The kinds of object a deployment role can be put on
+var deploymentRoleTargets = []string{"zone", "view", "network", "block"}
+
+// ResourceDNSDeploymentRole The deployment role of a server on a zone, view, network or block
+func ResourceDNSDeploymentRole() *schema.Resource {
+	return &schema.Resource{
+		Create:        createDeploymentRole,
+		Read:          getDeploymentRole,
+		Update:        updateDeploymentRole,
+		Delete:        deleteDeploymentRole,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Configuration. Using the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The view of the zone, or the view the role is put on if no zone, network or block is provided",
+			},
+			"zone": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The forward or reverse zone the role is put on",
+				ConflictsWith: []string{"network", "block"},
+			},
+			"network": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The CIDR of the IPv4 or IPv6 network the role is put on",
+				ConflictsWith: []string{"zone", "block"},
+			},
+			"block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The CIDR of the IPv4 or IPv6 block the role is put on",
+				ConflictsWith: []string{"zone", "network"},
+			},
+			"server_fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The FQDN of the server interface the role is given to",
+			},
+			"role_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "dns",
+				Description: "The type of the role: dns or dhcp. The DHCP roles are only on networks and blocks",
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					roleType := strings.ToLower(v.(string))
+					if roleType != "dns" && roleType != "dhcp" {
+						errs = append(errs, fmt.Errorf("%s must be dns or dhcp, got %q", k, v.(string)))
+					}
+					return
+				},
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The role, such as PRIMARY, PRIMARY_HIDDEN, SECONDARY, SECONDARY_STEALTH, FORWARDER, STUB, RECURSION or NONE for DNS, PRIMARY or NONE for DHCP",
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
+			},
+			"secondary_fqdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The FQDN of the second server interface, for the DHCP failover and the roles needing two interfaces",
+			},
+			"properties": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(v interface{}) string {
+					return utils.JoinProperties(utils.ParseProperties(v.(string)))
+				},
+				DiffSuppressFunc: suppressWhenRemoteHasSuperset,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: deploymentRoleImporter,
+		},
+	}
+}
+
+// getDHCPRoleNameInRestApi Get the name of the DHCP role in the REST API
+func getDHCPRoleNameInRestApi(roleNameInTerraform string) string {
+	// "NAME_IN_TERRAFORM": "NAME_IN_REST_API"
+	roles := map[string]string{
+		"PRIMARY": "MASTER",
+		"NONE":    "NONE",
+	}
+	return roles[roleNameInTerraform]
+}
+
+// getDHCPRoleNameInTerraform Get the name of the DHCP role in Terraform
+func getDHCPRoleNameInTerraform(roleNameInRestApi string) string {
+	// "NAME_IN_REST_API": "NAME_IN_TERRAFORM"
+	roles := map[string]string{
+		"MASTER": "PRIMARY",
+		"NONE":   "NONE",
+	}
+	return roles[roleNameInRestApi]
+}
+
+// deploymentRoleImporter Import the deployment role by its ID, the kind of object, the object and the server FQDN
+// joined by colons, such as zone:example.com:bdds1.example.com or network:10.0.0.0/24:bdds1.example.com
+func deploymentRoleImporter(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	target, ref, serverFQDN, err := deploymentRoleParseId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set(target, ref)
+	d.Set("server_fqdn", serverFQDN)
+	return []*schema.ResourceData{d}, nil
+}
+
+func deploymentRoleParseId(id string) (string, string, string, error) {
+	first := strings.Index(id, ":")
+	last := strings.LastIndex(id, ":")
+	if first <= 0 || last <= first+1 || last == len(id)-1 {
+		return "", "", "", fmt.Errorf("unexpected format of deployment role ID (%s), expected target:name:server_fqdn", id)
+	}
+	target := strings.ToLower(id[:first])
+	for _, known := range deploymentRoleTargets {
+		if target == known {
+			return target, id[first+1 : last], id[last+1:], nil
+		}
+	}
+	return "", "", "", fmt.Errorf("unexpected target %q in the deployment role ID (%s), expected one of %s", target, id, strings.Join(deploymentRoleTargets, ", "))
+}
+
+// getDeploymentRoleTarget Get the deployment role entity pointing to the zone, view, network or block, and the ID of the resource
+func getDeploymentRoleTarget(d *schema.ResourceData) (entities.DeploymentRole, string) {
+	target := entities.DeploymentRole{
+		Configuration: d.Get("configuration").(string),
+		View:          d.Get("view").(string),
+		ServerFQDN:    d.Get("server_fqdn").(string),
+	}
+	kind, ref := "view", target.View
+	if zone := d.Get("zone").(string); zone != "" {
+		kind, ref = "zone", zone
+		target.Zone = zone
+	}
+	for _, resourceType := range []string{"network", "block"} {
+		if cidr := d.Get(resourceType).(string); cidr != "" {
+			kind, ref = resourceType, cidr
+			target.View = ""
+			target.ResourceType = resourceType
+			target.ResourceRef = cidr
+			target.IPVersion = "ipv4"
+			if strings.Contains(cidr, ":") {
+				target.IPVersion = "ipv6"
+			}
+		}
+	}
+	return target, fmt.Sprintf("%s:%s:%s", kind, ref, target.ServerFQDN)
+}
+
+// getDeploymentRoleName Check the role against the role type and get its name in the REST API
+func getDeploymentRoleName(d *schema.ResourceData, target entities.DeploymentRole) (string, string, error) {
+	roleType := strings.ToLower(d.Get("role_type").(string))
+	role := strings.ToUpper(d.Get("role").(string))
+	if roleType == "dhcp" {
+		if target.ResourceType == "" {
+			return "", "", fmt.Errorf("the DHCP deployment roles can only be put on networks and blocks")
+		}
+		if name := getDHCPRoleNameInRestApi(role); name != "" {
+			return roleType, name, nil
+		}
+		return "", "", fmt.Errorf("invalid DHCP role: '%s', expected PRIMARY or NONE", role)
+	}
+	if name := getRoleNameInRestApi(role); name != "" {
+		return roleType, name, nil
+	}
+	return "", "", fmt.Errorf("invalid DNS role: '%s'", role)
+}
+
+// createDeploymentRole Create the new deployment role
+func createDeploymentRole(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	target, id := getDeploymentRoleTarget(d)
+	log.Debugf("Beginning to create the deployment role %s", id)
+	roleType, role, err := getDeploymentRoleName(d, target)
+	if err != nil {
+		return err
+	}
+
+	objMgr := GetObjManager(m)
+
+	if !checkServerExists(objMgr, target.Configuration, target.ServerFQDN) {
+		return fmt.Errorf("Server '%s' doesn't exists", target.ServerFQDN)
+	}
+	target.RoleType = roleType
+	target.Role = role
+	target.Properties = d.Get("properties").(string)
+	target.SecondaryFQDN = d.Get("secondary_fqdn").(string)
+	if _, err := objMgr.CreateObjectDeploymentRole(target); err != nil {
+		msg := fmt.Sprintf("Error creating the deployment role %s: %s", id, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	d.SetId(id)
+	log.Debugf("Completed to create the deployment role %s", id)
+	return getDeploymentRole(d, m)
+}
+
+// getDeploymentRole Get the deployment role
+func getDeploymentRole(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	target, id := getDeploymentRoleTarget(d)
+	log.Debugf("Beginning to get the deployment role %s", id)
+
+	objMgr := GetObjManager(m)
+
+	deploymentRole, err := objMgr.GetObjectDeploymentRole(target)
+	if err != nil {
+		if utils.IsNotFoundErr(err) && d.Id() != "" {
+			log.Warnf("Deployment role %q not found; removing from state to trigger recreation", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Getting the deployment role %s failed: %w", id, err)
+	}
+
+	roleType := strings.ToLower(deploymentRole.RoleType)
+	if roleType == "" {
+		roleType = strings.ToLower(d.Get("role_type").(string))
+	}
+	role := getRoleNameInTerraform(deploymentRole.Role)
+	if roleType == "dhcp" {
+		role = getDHCPRoleNameInTerraform(deploymentRole.Role)
+	}
+	if role == "" {
+		role = deploymentRole.Role
+	}
+
+	bamProps := utils.ParseProperties(deploymentRole.Properties)
+	cfgProps := utils.ParseProperties(d.Get("properties").(string))
+
+	d.SetId(id)
+	d.Set("role_type", roleType)
+	d.Set("role", role)
+	d.Set("secondary_fqdn", deploymentRole.SecondaryFQDN)
+	d.Set("properties", utils.JoinProperties(utils.FilterProperties(bamProps, cfgProps)))
+	log.Debugf("Completed reading the deployment role %s", id)
+	return nil
+}
+
+// updateDeploymentRole Update the role, the secondary server and the properties of the deployment role
+func updateDeploymentRole(d *schema.ResourceData, m interface{}) error {
+	target, id := getDeploymentRoleTarget(d)
+	log.Debugf("Beginning to update the deployment role %s", id)
+	roleType, role, err := getDeploymentRoleName(d, target)
+	if err != nil {
+		return err
+	}
+
+	objMgr := GetObjManager(m)
+
+	target.RoleType = roleType
+	target.Role = role
+	target.Properties = d.Get("properties").(string)
+	target.SecondaryFQDN = d.Get("secondary_fqdn").(string)
+	if _, err := objMgr.UpdateObjectDeploymentRole(target); err != nil {
+		msg := fmt.Sprintf("Error updating the deployment role %s: %s", id, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	log.Debugf("Completed to update the deployment role %s", id)
+	return getDeploymentRole(d, m)
+}
+
+// deleteDeploymentRole Delete the deployment role
+func deleteDeploymentRole(d *schema.ResourceData, m interface{}) error {
+	target, id := getDeploymentRoleTarget(d)
+	log.Debugf("Beginning to delete the deployment role %s", id)
+
+	objMgr := GetObjManager(m)
+
+	if _, err := objMgr.DeleteObjectDeploymentRole(target); err != nil && !utils.IsNotFoundErr(err) {
+		msg := fmt.Sprintf("Error deleting the deployment role %s: %s", id, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	d.SetId("")
+	log.Debugf("Completed to delete the deployment role %s", id)
+	return nil
+}
@@ -17,20 +17,23 @@ func init() {
 // ResourceExternalHostRecord The ExternalHost record
 func ResourceExternalHostRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: createExternalHostRecord,
-		Read:   getExternalHostRecord,
-		Update: updateExternalHostRecord,
-		Delete: deleteExternalHostRecord,
+		Create:        createExternalHostRecord,
+		Read:          getExternalHostRecord,
+		Update:        updateExternalHostRecord,
+		Delete:        deleteExternalHostRecord,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the External Host record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the record. If not provided, record will be created under default view",
 			},
 			"absolute_name": {
@@ -57,6 +60,11 @@ func ResourceExternalHostRecord() *schema.Resource {
 				Description: "Whether or not to selectively deploy the Host record",
 				Default:     "no",
 			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the External Host record",
+			},
 			"batch_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -78,6 +86,7 @@ func ResourceExternalHostRecord() *schema.Resource {
 
 // createExternalHostRecord Create the new ExternalHost record
 func createExternalHostRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to create ExternalHost record %s", d.Get("absolute_name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -95,25 +104,28 @@ func createExternalHostRecord(d *schema.ResourceData, m interface{}) error {
 		log.Debug(msg)
 		return fmt.Errorf(msg)
 	}
+	d.SetId(absoluteName)
+	d.Set("absolute_name", absoluteName)
+	d.Set("bam_id", externalHostRecord.BAMId)
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		externalHostRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{externalHostRecord.BAMId}, externalHostRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{externalHostRecord.BAMId}, externalHostRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying External Host record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
-	d.Set("absolute_name", absoluteName)
-	d.Set("bam_id", externalHostRecord.BAMId)
 	log.Debugf("Completed to create ExternalHost record %s", d.Get("absolute_name"))
 	return getExternalHostRecord(d, m)
 }
 
 // getExternalHostRecord Get the ExternalHost record
 func getExternalHostRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get ExternalHost record: %s", d.Get("absolute_name"))
 	// During import, the full FQDN is stored in ID and must be used as absolute_name.
 	absoluteName, err := getAbsoluteName(d)
@@ -188,13 +200,14 @@ func updateExternalHostRecord(d *schema.ResourceData, m interface{}) error {
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		externalHostRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{externalHostRecord.BAMId}, externalHostRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{externalHostRecord.BAMId}, externalHostRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying External Host record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
 	d.Set("absolute_name", absoluteName)
 	d.Set("bam_id", externalHostRecord.BAMId)
@@ -227,7 +240,7 @@ func deleteExternalHostRecord(d *schema.ResourceData, m interface{}) error {
 		}
 		deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 		if deploy {
-			res, err := objMgr.Connector.DeployObject([]int{bamID}, d.Get("batch_mode").(string))
+			res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
 			if err != nil {
 				msg := fmt.Sprintf("Error deploying External Host record %s: %s", absoluteName, err)
 				log.Debug(msg)
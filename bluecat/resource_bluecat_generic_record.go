@@ -12,25 +12,29 @@ import (
 // ResourceGenericRecord The Generic record
 func ResourceGenericRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: createGenericRecord,
-		Read:   getGenericRecord,
-		Update: updateGenericRecord,
-		Delete: deleteGenericRecord,
+		Create:        createGenericRecord,
+		Read:          getGenericRecord,
+		Update:        updateGenericRecord,
+		Delete:        deleteGenericRecord,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("absolute_name")),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the Generic record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
 			},
 			"zone": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Zone in which you want to update a Generic record. If not provided, the absolute name must be FQDN ones",
 			},
 			"type": {
@@ -76,6 +80,11 @@ func ResourceGenericRecord() *schema.Resource {
 				Description: "Whether or not to selectively deploy the Generic record",
 				Default:     "no",
 			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the Generic record",
+			},
 			"batch_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -96,6 +105,8 @@ func ResourceGenericRecord() *schema.Resource {
 
 // createGenericRecord Create the new Generic record
 func createGenericRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
 	log.Debugf("Beginning to create Generic record %s", d.Get("absolute_name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -124,25 +135,28 @@ func createGenericRecord(d *schema.ResourceData, m interface{}) error {
 		log.Debug(msg)
 		return fmt.Errorf(msg)
 	}
+	d.SetId(fqdnName)
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", genericRecord.BAMId)
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		genericRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{genericRecord.BAMId}, genericRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{genericRecord.BAMId}, genericRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying Generic record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
-	d.Set("absolute_name", fqdnName)
-	d.Set("bam_id", genericRecord.BAMId)
 	log.Debugf("Completed to create Generic record %s", d.Get("absolute_name"))
 	return getGenericRecord(d, m)
 }
 
 // getGenericRecord Get the Generic record
 func getGenericRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get Generic record: %s", d.Get("absolute_name"))
 	absoluteName, err := getAbsoluteName(d)
 	configuration := d.Get("configuration").(string)
@@ -218,13 +232,14 @@ func updateGenericRecord(d *schema.ResourceData, m interface{}) error {
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		genericRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{genericRecord.BAMId}, genericRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{genericRecord.BAMId}, genericRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying Generic record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
 	d.Set("absolute_name", fqdnName)
 	d.Set("bam_id", genericRecord.BAMId)
@@ -252,7 +267,7 @@ func deleteGenericRecord(d *schema.ResourceData, m interface{}) error {
 	}
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
-		res, err := objMgr.Connector.DeployObject([]int{bamID}, d.Get("batch_mode").(string))
+		res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying Generic record %s: %s", absoluteName, err)
 			log.Debug(msg)
@@ -21,25 +21,29 @@ func init() {
 // ResourceHostRecord The Host record
 func ResourceHostRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: createHostRecord,
-		Read:   getHostRecord,
-		Update: updateHostRecord,
-		Delete: deleteHostRecord,
+		Create:        createHostRecord,
+		Read:          getHostRecord,
+		Update:        updateHostRecord,
+		Delete:        deleteHostRecord,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("absolute_name")),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the Host record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
 			},
 			"zone": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Zone in which you want to update a host record. If not provided, the absolute name must be FQDN ones",
 			},
 			"absolute_name": {
@@ -52,9 +56,37 @@ func ResourceHostRecord() *schema.Resource {
 				},
 			},
 			"ip_address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The IP address that will be linked to the Host record",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"ip_address", "addresses"},
+				Description:  "The IP address that will be linked to the Host record, or several comma-separated addresses",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return sameHostAddresses(old, new)
+				},
+			},
+			"addresses": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"ip_address", "addresses"},
+				Description:  "The IPv4 and IPv6 addresses that will be linked to the Host record, with the reverse record of each",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IPv4 or IPv6 address",
+							StateFunc: func(v interface{}) string {
+								return normalizeIPAddress(v.(string))
+							},
+						},
+						"reverse_record": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether or not to create the PTR record of the address",
+						},
+					},
+				},
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -76,6 +108,11 @@ func ResourceHostRecord() *schema.Resource {
 				Description: "Whether or not to selectively deploy the Host record",
 				Default:     "no",
 			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the Host record",
+			},
 			"batch_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -109,6 +146,8 @@ func recordParseId(id string) (string, string, error) {
 
 // createHostRecord Create the new Host record
 func createHostRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
 	log.Debugf("Beginning to create Host record %s", d.Get("absolute_name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -133,31 +172,43 @@ func createHostRecord(d *schema.ResourceData, m interface{}) error {
 	// Make sure the reverseRecord property is properly capitalized (if it exists)
 	properties, err := fixReverseRecordPropIfExists(properties)
 
-	hostRecord, err := objMgr.CreateHostRecord(configuration, view, zone, fqdnName, ipAddress, ttl, properties)
+	reverseRecord := ""
+	if addresses := getHostAddresses(d); len(addresses) > 0 {
+		ipAddress, reverseRecord, properties, err = buildHostAddressesRequest(addresses, properties)
+		if err != nil {
+			return fmt.Errorf("Error creating Host record %s: %s", fqdnName, err)
+		}
+	}
+
+	hostRecord, err := objMgr.CreateHostRecord(configuration, view, zone, fqdnName, ipAddress, reverseRecord, ttl, properties)
 	if err != nil {
 		msg := fmt.Sprintf("Error creating Host record %s: %s", fqdnName, err)
 		log.Debug(msg)
 		return fmt.Errorf(msg)
 	}
+	// saved before deploying: a failed deployment leaves the record tainted instead of orphaned
+	d.SetId(fqdnName)
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", hostRecord.BAMId)
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		hostRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{hostRecord.BAMId}, hostRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{hostRecord.BAMId}, hostRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying Host record %s: %s", fqdnName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
-	d.Set("absolute_name", fqdnName)
-	d.Set("bam_id", hostRecord.BAMId)
 	log.Debugf("Completed to create Host record %s", d.Get("absolute_name"))
 	return getHostRecord(d, m)
 }
 
 // getHostRecord Get the Host record
 func getHostRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get Host record: %s", d.Get("absolute_name"))
 	absoluteName, err := getAbsoluteName(d)
 	configuration := d.Get("configuration").(string)
@@ -194,8 +245,16 @@ func getHostRecord(d *schema.ResourceData, m interface{}) error {
 	d.Set("absolute_name", hostRecord.AbsoluteName)
 	d.Set("bam_id", hostRecord.BAMId)
 	d.Set("properties", utils.JoinProperties(filteredProperties))
-	// for import functionality ip4_address must be set for the host_record - required attribute
-	d.Set("ip_address", parseRecordPropertyValue(hostRecord.Properties, "addresses"))
+	// for import functionality the addresses must be set for the host_record, in the attribute used by the
+	// configuration, or in addresses when an imported host has several of them
+	remoteAddresses := parseRecordPropertyValue(hostRecord.Properties, "addresses")
+	known := getHostAddresses(d)
+	if len(known) > 0 || (d.Get("ip_address").(string) == "" && len(splitHostAddresses(remoteAddresses)) > 1) {
+		reverseProperty := parseRecordPropertyValue(hostRecord.Properties, "reverseRecord")
+		d.Set("addresses", flattenHostAddresses(splitHostAddresses(remoteAddresses), hostRecord.ReverseRecord, reverseProperty, known))
+	} else {
+		d.Set("ip_address", remoteAddresses)
+	}
 	log.Debugf("Completed reading Host record %s", d.Get("absolute_name"))
 	return nil
 }
@@ -226,10 +285,18 @@ func updateHostRecord(d *schema.ResourceData, m interface{}) error {
 	// Make sure the reverseRecord property is properly capitalized (if it exists)
 	properties, err := fixReverseRecordPropIfExists(properties)
 
+	reverseRecord := ""
+	if addresses := getHostAddresses(d); len(addresses) > 0 {
+		ipAddress, reverseRecord, properties, err = buildHostAddressesRequest(addresses, properties)
+		if err != nil {
+			return fmt.Errorf("Error updating Host record %s: %s", fqdnName, err)
+		}
+	}
+
 	var immutableProperties = []string{"parentId", "parentType"} // these properties will raise error on the rest-api
 	properties = utils.RemoveImmutableProperties(properties, immutableProperties)
 
-	hostRecord, err := objMgr.UpdateHostRecord(configuration, view, zone, fqdnName, ipAddress, ttl, properties)
+	hostRecord, err := objMgr.UpdateHostRecord(configuration, view, zone, fqdnName, ipAddress, reverseRecord, ttl, properties)
 	if err != nil {
 		msg := fmt.Sprintf("Error updating Host record %s: %s", fqdnName, err)
 		log.Debug(msg)
@@ -239,13 +306,14 @@ func updateHostRecord(d *schema.ResourceData, m interface{}) error {
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		hostRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{hostRecord.BAMId}, hostRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{hostRecord.BAMId}, hostRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying Host record %s: %s", fqdnName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
 	d.Set("absolute_name", fqdnName)
 	d.Set("bam_id", hostRecord.BAMId)
@@ -272,7 +340,7 @@ func deleteHostRecord(d *schema.ResourceData, m interface{}) error {
 	}
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
-		res, err := objMgr.Connector.DeployObject([]int{bamID}, d.Get("batch_mode").(string))
+		res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying Host record %s: %s", absoluteName, err)
 			log.Debug(msg)
@@ -3,7 +3,11 @@
 package bluecat
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 	"terraform-provider-bluecat/bluecat/entities"
@@ -15,20 +19,23 @@ import (
 // ResourceIPAllocation The IP Allocation
 func ResourceIPAllocation() *schema.Resource {
 	return &schema.Resource{
-		Create: createIPAllocation,
-		Read:   getIPAllocation,
-		Update: updateIPAllocation,
-		Delete: deleteIPAllocation,
+		Create:        createIPAllocation,
+		Read:          getIPAllocation,
+		Update:        updateIPAllocation,
+		Delete:        deleteIPAllocation,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Allocating the IP address/Host record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
 			},
 			"zone": {
@@ -69,6 +76,56 @@ func ResourceIPAllocation() *schema.Resource {
 				Optional:    true,
 				Description: "The MAC address",
 			},
+			"allocation_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The token stored on the next available IP address, to find it again when the allocation is retried. Derived from the configuration, the network and the name if not provided",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old != ""
+				},
+			},
+			"offset": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The number of addresses skipped from the start, or from the end if the direction is highest, of the network when allocating the next available IP address",
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if v.(int) < 0 {
+						errs = append(errs, fmt.Errorf("%s must not be negative, got %d", k, v.(int)))
+					}
+					return
+				},
+				DiffSuppressFunc: suppressAfterAllocation,
+			},
+			"direction": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "lowest",
+				Description: "Whether to allocate the lowest or the highest next available IP address of the network",
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					direction := v.(string)
+					if direction != "lowest" && direction != "highest" {
+						errs = append(errs, fmt.Errorf("%s must be lowest or highest, got %q", k, direction))
+					}
+					return
+				},
+				DiffSuppressFunc: suppressAfterAllocation,
+			},
+			"exclude": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				Description:      "The addresses, ranges such as 10.0.0.1-10.0.0.9 and CIDRs never allocated as the next available IP address",
+				DiffSuppressFunc: suppressAfterAllocation,
+			},
+			"exclude_dhcp_ranges": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				Description:      "Whether or not to keep the next available IP address out of the DHCP ranges of the network",
+				DiffSuppressFunc: suppressAfterAllocation,
+			},
 			"properties": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -80,8 +137,14 @@ func ResourceIPAllocation() *schema.Resource {
 			"action": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Desired IP4 address state: MAKE_STATIC / MAKE_RESERVED / MAKE_DHCP_RESERVED",
+				Description: "Desired IP4 address state: MAKE_STATIC / MAKE_RESERVED / MAKE_DHCP_RESERVED. Changing it moves the address to the new state in place",
 				Default:     entities.AllocateStatic,
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if _, ok := ipStates[v.(string)]; !ok {
+						errs = append(errs, fmt.Errorf("%s must be %s, %s or %s, got %q", k, entities.AllocateStatic, entities.AllocateReserved, entities.AllocateDHCPReserved, v.(string)))
+					}
+					return
+				},
 			},
 			"template": {
 				Type:        schema.TypeString,
@@ -96,9 +159,14 @@ func ResourceIPAllocation() *schema.Resource {
 			"to_deploy": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Whether or not to selectively deploy the Host record",
+				Description: "Whether or not to selectively deploy the Host record, or the DHCP reserved address to the DHCP servers",
 				Default:     "no",
 			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the Host record or the DHCP reserved address",
+			},
 			"batch_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -113,6 +181,7 @@ func ResourceIPAllocation() *schema.Resource {
 // Create the host record if the zone name is provided
 // In case of allocating the IP address, the network must be specified
 func createIPAllocation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	objMgr := GetObjManager(m)
 
 	address := entities.IPAddress{}
@@ -135,6 +204,7 @@ func createIPAllocation(d *schema.ResourceData, m interface{}) error {
 	}
 
 	createIP := true
+	recovered := false
 	if len(address.Address) != 0 {
 		_, err := objMgr.GetIPAddress(address.Configuration, address.Address, address.IPVersion)
 		if err != nil {
@@ -148,16 +218,45 @@ func createIPAllocation(d *schema.ResourceData, m interface{}) error {
 		}
 	} else {
 		address.CIDR = strings.Split(network, "/")[0]
+		address.AllocationToken = getAllocationToken(d, address.Configuration, network, fqdnName)
+		d.Set("allocation_token", address.AllocationToken)
+		allocated, err := objMgr.GetIPAddressByAllocationToken(address.Configuration, address.CIDR, address.AllocationToken, address.IPVersion)
+		if err == nil && allocated.Address != "" {
+			// a previous attempt reserved the address but failed afterwards
+			log.Debugf("Found the IP address %s allocated with the token %s", allocated.Address, address.AllocationToken)
+			address.Address = allocated.Address
+			createIP = false
+			recovered = true
+		} else if err != nil && !utils.IsNotFoundErr(err) {
+			// allocating another address would orphan the one the token may still hold
+			msg := fmt.Sprintf("Getting the IP address by the allocation token %s failed: %s", address.AllocationToken, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+	}
+
+	// rollback Release what this attempt created when a later step fails, so the address isn't orphaned
+	rollbacks := make([]func() error, 0)
+	rollback := func(cause error) error {
+		for i := len(rollbacks) - 1; i >= 0; i-- {
+			if err := rollbacks[i](); err != nil && !utils.IsNotFoundErr(err) {
+				log.Errorf("Rolling back the allocation of the IP address %s failed: %s", address.Address, err)
+				// keep the partial state so the address is tracked and found again by its token
+				return cause
+			}
+		}
+		d.SetId("")
+		return cause
 	}
+
 	if createIP {
 		log.Debugf("Allocating the IP address under network %s", network)
-		ipProperties := address.Properties
-		if len(zone) > 0 {
-			ipProperties = ""
+		if len(address.Address) == 0 {
+			if err := setNextIPAddressStrategy(d, &address); err != nil {
+				return err
+			}
 		}
-		if address.Action == entities.AllocateStatic && address.IPVersion == entities.IPV4 {
-			ipProperties = fmt.Sprintf("%s|excludeDHCPRange=true", ipProperties)
-		} else if address.Action == entities.AllocateReserved {
+		if address.Action == entities.AllocateReserved {
 			address.Name = strings.Split(fqdnName, fmt.Sprintf(".%s", zone))[0][0:]
 		}
 		newIPAddress, err := objMgr.CreateIPAddress(address)
@@ -172,6 +271,20 @@ func createIPAllocation(d *schema.ResourceData, m interface{}) error {
 			log.Debugf("Got the IP address %s", address.Address)
 		}
 	}
+	if createIP || recovered {
+		// the address found again by its token was reserved by a previous attempt, it is released alike
+		allocatedAddress := address.Address
+		rollbacks = append(rollbacks, func() error {
+			log.Debugf("Rolling back the allocation of the IP address %s", allocatedAddress)
+			_, err := objMgr.DeleteIPAddress(address.Configuration, allocatedAddress, address.IPVersion)
+			return err
+		})
+	}
+
+	// save the reserved address right away: a failure below leaves it in the state
+	d.Set("ip_address", address.Address)
+	d.Set("name", fqdnName)
+	d.SetId(fqdnName)
 
 	if len(address.Mac) > 0 {
 		log.Debugf("Updating the MAC address for the IP address %s", address.Address)
@@ -180,21 +293,26 @@ func createIPAllocation(d *schema.ResourceData, m interface{}) error {
 		if err != nil {
 			msg := fmt.Sprintf("Updating IP address %s failed: %s", address.Address, err)
 			log.Debug(msg)
-			return fmt.Errorf(msg)
+			return rollback(fmt.Errorf(msg))
 		}
 	}
 
-	d.Set("ip_address", address.Address)
-	d.Set("name", fqdnName)
-
 	if len(zone) > 0 {
 		if address.Action != entities.AllocateReserved {
-			log.Debugf("Creating the Host record %s", fqdnName)
-			hostRecord, err := objMgr.CreateHostRecord(address.Configuration, view, zone, fqdnName, address.Address, -1, address.Properties)
-			if err != nil {
-				msg := fmt.Sprintf("Error creating the Host record %s: %s", fqdnName, err)
-				log.Debug(msg)
-				return fmt.Errorf(msg)
+			hostRecord, err := objMgr.GetHostRecord(address.Configuration, view, fqdnName)
+			if err != nil || !strings.Contains(utils.GetPropertyValue("addresses", hostRecord.Properties), address.Address) {
+				log.Debugf("Creating the Host record %s", fqdnName)
+				hostRecord, err = objMgr.CreateHostRecord(address.Configuration, view, zone, fqdnName, address.Address, "", -1, address.Properties)
+				if err != nil {
+					msg := fmt.Sprintf("Error creating the Host record %s: %s", fqdnName, err)
+					log.Debug(msg)
+					return rollback(fmt.Errorf(msg))
+				}
+				rollbacks = append(rollbacks, func() error {
+					log.Debugf("Rolling back the Host record %s", fqdnName)
+					_, err := objMgr.DeleteHostRecord(address.Configuration, view, fqdnName)
+					return err
+				})
 			}
 			if d.Get("action").(string) == "MAKE_STATIC" {
 				to_deploy := d.Get("to_deploy")
@@ -202,13 +320,14 @@ func createIPAllocation(d *schema.ResourceData, m interface{}) error {
 					deploy := utils.ParseDeploymentValue(to_deploy.(string))
 					if deploy {
 						hostRecord.BatchMode = d.Get("batch_mode").(string)
-						res, err := objMgr.Connector.DeployObject([]int{hostRecord.BAMId}, hostRecord.BatchMode)
+						status, err := objMgr.DeployObjects([]int{hostRecord.BAMId}, hostRecord.BatchMode)
+						d.Set("last_deployment_status", status)
 						if err != nil {
 							msg := fmt.Sprintf("Error deploying IP Allocation record %s: %s", fqdnName, err)
 							log.Debug(msg)
 							return fmt.Errorf(msg)
 						}
-						log.Debugf("Successfully deployed. %s", res)
+						log.Debugf("Successfully deployed. %s", status)
 					}
 				}
 			}
@@ -218,12 +337,93 @@ func createIPAllocation(d *schema.ResourceData, m interface{}) error {
 		log.Debugf("Finished to create the Host record %s", fqdnName)
 	}
 
+	err := deployDHCPReservedAddress(d, objMgr, address)
+	if err != nil {
+		return err
+	}
 	log.Debugf("Completed to allocate IP address %s", address.Address)
 	return getIPAllocation(d, m)
 }
 
+// suppressAfterAllocation Ignore the changes of the attributes only used to allocate the next available IP address
+func suppressAfterAllocation(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// setNextIPAddressStrategy Set how the next available IP address is chosen: the offset, the direction,
+// the excluded addresses and whether or not to stay out of the DHCP ranges, for IPv4 and IPv6
+func setNextIPAddressStrategy(d *schema.ResourceData, address *entities.IPAddress) error {
+	exclude := make([]string, 0)
+	for _, item := range d.Get("exclude").([]interface{}) {
+		exclude = append(exclude, item.(string))
+	}
+	normalized, err := normalizeIPExclusions(exclude, address.IPVersion)
+	if err != nil {
+		return err
+	}
+	address.Offset = d.Get("offset").(int)
+	address.Direction = d.Get("direction").(string)
+	address.Exclude = normalized
+	address.ExcludeDHCPRange = d.Get("exclude_dhcp_ranges").(bool)
+	return nil
+}
+
+// normalizeIPExclusions Check the excluded addresses, ranges and CIDRs are of the IP version and get them in their canonical form
+func normalizeIPExclusions(exclude []string, ipVersion string) ([]string, error) {
+	result := make([]string, 0, len(exclude))
+	parse := func(item string, value string) (net.IP, error) {
+		ip := net.ParseIP(strings.TrimSpace(value))
+		if ip == nil || (ip.To4() != nil) != (ipVersion != entities.IPV6) {
+			return nil, fmt.Errorf("invalid %s exclusion %q: expected an address, a range or a CIDR", ipVersion, item)
+		}
+		return ip, nil
+	}
+	for _, item := range exclude {
+		switch {
+		case strings.Contains(item, "/"):
+			ip, ipNet, err := net.ParseCIDR(strings.TrimSpace(item))
+			if err != nil || (ip.To4() != nil) != (ipVersion != entities.IPV6) {
+				return nil, fmt.Errorf("invalid %s exclusion %q: expected an address, a range or a CIDR", ipVersion, item)
+			}
+			result = append(result, ipNet.String())
+		case strings.Contains(item, "-"):
+			bounds := strings.SplitN(item, "-", 2)
+			start, err := parse(item, bounds[0])
+			if err != nil {
+				return nil, err
+			}
+			end, err := parse(item, bounds[1])
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Compare(start.To16(), end.To16()) > 0 {
+				return nil, fmt.Errorf("invalid %s exclusion %q: the range ends before it starts", ipVersion, item)
+			}
+			result = append(result, fmt.Sprintf("%s-%s", start, end))
+		default:
+			ip, err := parse(item, item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, ip.String())
+		}
+	}
+	return result, nil
+}
+
+// getAllocationToken Get the allocation token of the next available IP address: the configured one, or one derived
+// from the configuration, the network and the name, which is the same on every retry
+func getAllocationToken(d *schema.ResourceData, configuration string, network string, fqdnName string) string {
+	if token := d.Get("allocation_token").(string); token != "" {
+		return token
+	}
+	sum := sha1.Sum([]byte(strings.ToLower(fmt.Sprintf("%s/%s/%s", configuration, network, fqdnName))))
+	return hex.EncodeToString(sum[:10])
+}
+
 // getIPAllocation Get the allocated IP address/Host info
 func getIPAllocation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 
 	objMgr := GetObjManager(m)
 
@@ -319,6 +519,7 @@ func deleteIPAllocation(d *schema.ResourceData, m interface{}) error {
 }
 
 // updateAllocatedResource Update the allocated IP address/Host record
+// The address moves between the reserved, static and DHCP reserved states in place, it is never released in between
 func updateAllocatedResource(d *schema.ResourceData, m interface{}) error {
 
 	objMgr := GetObjManager(m)
@@ -341,56 +542,6 @@ func updateAllocatedResource(d *schema.ResourceData, m interface{}) error {
 		zone = getZoneFromRRName(fqdnName)
 	}
 
-	if len(zone) > 0 {
-		log.Debugf("Updating host record %s", fqdnName)
-		hostRecord, err := objMgr.GetHostRecord(address.Configuration, view, fqdnName)
-		if err == nil {
-			// Keeps values as in the server
-			log.Debugf(hostRecord.Properties)
-			TTL := utils.GetPropertyValue("ttl", hostRecord.Properties)
-			rrTTL, err := strconv.Atoi(TTL)
-			if err != nil {
-				msg := fmt.Sprintf("Convert Host record TTL %s failed: %s", TTL, err)
-				log.Debug(msg)
-				rrTTL = -1
-			}
-
-			associateIPs := address.Address
-			currentAssociateIPs := utils.GetPropertyValue("addresses", hostRecord.Properties)
-			if len(currentAssociateIPs) > 0 {
-				associateIPs = fmt.Sprintf("%s,%s", currentAssociateIPs, address.Address)
-			}
-
-			var immutableProperties = []string{"parentId", "parentType"} // these properties will raise error on the rest-api
-			address.Properties = utils.RemoveImmutableProperties(address.Properties, immutableProperties)
-
-			hostRecord, err = objMgr.UpdateHostRecord(address.Configuration, view, zone, fqdnName, associateIPs, rrTTL, address.Properties)
-			if err != nil {
-				msg := fmt.Sprintf("Error updating Host record %s: %s", fqdnName, err)
-				log.Debug(msg)
-				return fmt.Errorf(msg)
-			}
-			if action, ok := d.Get("action").(string); ok && action == "MAKE_STATIC" {
-				to_deploy := d.Get("to_deploy")
-				if to_deploy != nil {
-					deploy := utils.ParseDeploymentValue(to_deploy.(string))
-					if deploy {
-						hostRecord.BatchMode = d.Get("batch_mode").(string)
-						res, err := objMgr.Connector.DeployObject([]int{hostRecord.BAMId}, hostRecord.BatchMode)
-						if err != nil {
-							msg := fmt.Sprintf("Error deploying IP Allocation record %s: %s", fqdnName, err)
-							log.Debug(msg)
-							return fmt.Errorf(msg)
-						}
-						log.Debugf("Successfully deployed. %s", res)
-					}
-				}
-			}
-		} else {
-			msg := fmt.Sprintf("Getting Host record %s failed: %s", address.Name, err)
-			log.Debug(msg)
-		}
-	}
 	log.Debugf("Updating IP address %s", address.Address)
 	ipAddress, err := objMgr.GetIPAddress(address.Configuration, address.Address, address.IPVersion)
 	if err != nil {
@@ -398,7 +549,27 @@ func updateAllocatedResource(d *schema.ResourceData, m interface{}) error {
 		log.Debug(msg)
 		return fmt.Errorf(msg)
 	}
+	currentState := getAttributeFromProperties("state", ipAddress.Properties)
+	transition, err := getIPStateTransition(currentState, address.Action, len(zone) > 0)
+	if _, stateErr := getIPState(currentState); stateErr != nil && !(d.Id() != "" && d.HasChange("action")) {
+		// the address isn't in a state the action moves it from, it stays in it unless the action changes
+		log.Debugf("Keeping the IP address %s in its state %q: %s", address.Address, currentState, err)
+		transition, err = getIPStateKept(currentState, address.Action, len(zone) > 0), nil
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Changing the state of the IP address %s failed: %s", address.Address, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	if transition.NeedsMAC && address.Mac == "" && getAttributeFromProperties("macAddress", ipAddress.Properties) == "" {
+		msg := fmt.Sprintf("The IP address %s needs a MAC address to be %s", address.Address, transition.To)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	log.Debugf("Moving the IP address %s from %s to %s", address.Address, transition.From, transition.To)
+
 	// The properties field belongs to Host record if the zone field is not none
+	hostProperties := address.Properties
 	if len(zone) > 0 {
 		address.Properties = ""
 	} else {
@@ -409,15 +580,9 @@ func updateAllocatedResource(d *schema.ResourceData, m interface{}) error {
 		address.Properties = removeAttributeFromProperties("macAddress", address.Properties)
 	}
 
-	ipAddress.Action = getAttributeFromProperties("state", ipAddress.Properties)
-	ipAddress.SetAction()
-
-	// if old ip address state is the same as the new then do not try to change to the same state
-	// because that will raise a bug on the REST-API side
-	if address.Action == ipAddress.Action {
-		address.Action = ""
-	}
-	if address.Action == "RESERVED" || address.Action == entities.AllocateReserved {
+	// do not try to change to the same state because that will raise a bug on the REST-API side
+	address.Action = transition.Action
+	if transition.To == "RESERVED" {
 		address.Name = strings.Split(fqdnName, fmt.Sprintf(".%s", zone))[0][0:]
 	}
 	_, err = objMgr.UpdateIPAddress(address)
@@ -427,6 +592,142 @@ func updateAllocatedResource(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(msg)
 	}
 
+	// the state changes first: the Host record is only unlinked once the address is reserved
+	if len(zone) > 0 {
+		err = updateAllocatedHostRecord(d, objMgr, address, transition, view, zone, fqdnName, hostProperties)
+		if err != nil {
+			return err
+		}
+	}
+
+	if transition.UndeployDHCP {
+		// the address is no longer DHCP reserved, deploying its network removes it from the DHCP servers
+		network := d.Get("network").(string)
+		err = deployDHCPObject(d, objMgr, address.IPVersion, fmt.Sprintf("the network %s of the formerly DHCP reserved address %s", network, address.Address), func() (int, error) {
+			network, err := objMgr.GetNetwork(&entities.Network{
+				Configuration: address.Configuration,
+				CIDR:          network,
+				IPVersion:     address.IPVersion,
+			})
+			if err != nil {
+				return 0, err
+			}
+			return network.NetWorkId, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if transition.DeployDHCP {
+		err = deployDHCPReservedAddress(d, objMgr, address)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Debugf("Completed to update the allocated resource in network %s", d.Get("network"))
 	return nil
 }
+
+// updateAllocatedHostRecord Create, update or unlink the Host record of the allocated IP address for its new state
+func updateAllocatedHostRecord(d *schema.ResourceData, objMgr *utils.ObjectManager, address entities.IPAddress, transition ipStateTransition, view string, zone string, fqdnName string, properties string) error {
+	hostRecord, err := objMgr.GetHostRecord(address.Configuration, view, fqdnName)
+	if err != nil {
+		if !transition.CreateHostRecord {
+			log.Debugf("Getting Host record %s failed: %s", fqdnName, err)
+			return nil
+		}
+		log.Debugf("Creating the Host record %s", fqdnName)
+		hostRecord, err = objMgr.CreateHostRecord(address.Configuration, view, zone, fqdnName, address.Address, "", -1, properties)
+		if err != nil {
+			msg := fmt.Sprintf("Error creating the Host record %s: %s", fqdnName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		return deployAllocatedHostRecord(d, objMgr, transition, fqdnName, hostRecord.BAMId, false)
+	}
+
+	// Keeps values as in the server
+	log.Debugf(hostRecord.Properties)
+	TTL := utils.GetPropertyValue("ttl", hostRecord.Properties)
+	rrTTL, err := strconv.Atoi(TTL)
+	if err != nil {
+		msg := fmt.Sprintf("Convert Host record TTL %s failed: %s", TTL, err)
+		log.Debug(msg)
+		rrTTL = -1
+	}
+
+	associateIPs := make([]string, 0)
+	for _, ip := range strings.Split(utils.GetPropertyValue("addresses", hostRecord.Properties), ",") {
+		if ip = strings.TrimSpace(ip); ip != "" && ip != address.Address {
+			associateIPs = append(associateIPs, ip)
+		}
+	}
+	if transition.RemoveHostRecord {
+		if len(associateIPs) == 0 {
+			log.Debugf("Deleting the Host record %s of the reserved IP address %s", fqdnName, address.Address)
+			_, err = objMgr.DeleteHostRecord(address.Configuration, view, fqdnName)
+			if err != nil && !utils.IsNotFoundErr(err) {
+				msg := fmt.Sprintf("Delete Host record %s failed: %s", fqdnName, err)
+				log.Debug(msg)
+				return fmt.Errorf(msg)
+			}
+			return deployAllocatedHostRecord(d, objMgr, transition, fqdnName, hostRecord.BAMId, true)
+		}
+		log.Debugf("Unlinking the reserved IP address %s from the Host record %s", address.Address, fqdnName)
+		properties = ""
+	} else {
+		associateIPs = append(associateIPs, address.Address)
+	}
+
+	var immutableProperties = []string{"parentId", "parentType"} // these properties will raise error on the rest-api
+	properties = utils.RemoveImmutableProperties(properties, immutableProperties)
+
+	bamID := hostRecord.BAMId
+	hostRecord, err = objMgr.UpdateHostRecord(address.Configuration, view, zone, fqdnName, strings.Join(associateIPs, ","), "", rrTTL, properties)
+	if err != nil {
+		msg := fmt.Sprintf("Error updating Host record %s: %s", fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	if hostRecord.BAMId != 0 {
+		bamID = hostRecord.BAMId
+	}
+	return deployAllocatedHostRecord(d, objMgr, transition, fqdnName, bamID, false)
+}
+
+// deployAllocatedHostRecord Deploy the Host record of the allocated IP address to the DNS servers if required
+func deployAllocatedHostRecord(d *schema.ResourceData, objMgr *utils.ObjectManager, transition ipStateTransition, fqdnName string, bamID int, deleted bool) error {
+	if !transition.DeployDNS || !utils.ParseDeploymentValue(d.Get("to_deploy").(string)) {
+		return nil
+	}
+	var status string
+	var err error
+	if deleted {
+		status, err = objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
+	} else {
+		status, err = objMgr.DeployObjects([]int{bamID}, d.Get("batch_mode").(string))
+	}
+	d.Set("last_deployment_status", status)
+	if err != nil {
+		msg := fmt.Sprintf("Error deploying IP Allocation record %s: %s", fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	log.Debugf("Successfully deployed. %s", status)
+	return nil
+}
+
+// deployDHCPReservedAddress Deploy the DHCP reserved address to the DHCP servers
+func deployDHCPReservedAddress(d *schema.ResourceData, objMgr *utils.ObjectManager, address entities.IPAddress) error {
+	if d.Get("action").(string) != entities.AllocateDHCPReserved {
+		return nil
+	}
+	return deployDHCPObject(d, objMgr, address.IPVersion, fmt.Sprintf("DHCP reserved address %s", address.Address), func() (int, error) {
+		ipAddress, err := objMgr.GetIPAddress(address.Configuration, address.Address, address.IPVersion)
+		if err != nil {
+			return 0, err
+		}
+		return ipAddress.BAMId, nil
+	})
+}
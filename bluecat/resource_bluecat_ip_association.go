@@ -15,20 +15,23 @@ import (
 // ResourceIPAssociation The IP Association
 func ResourceIPAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: createIPAssociation,
-		Read:   getIPAssociation,
-		Update: updateIPAssociation,
-		Delete: deleteIPAssociation,
+		Create:        createIPAssociation,
+		Read:          getIPAssociation,
+		Update:        updateIPAssociation,
+		Delete:        deleteIPAssociation,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Associate the IP address/Host record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, uses the default view",
 			},
 			"zone": {
@@ -79,6 +82,7 @@ func ResourceIPAssociation() *schema.Resource {
 
 // createIPAssociation Associate the IP address/Host record
 func createIPAssociation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to associate IP address %s", d.Get("ip_address"))
 	err := updateAllocatedResource(d, m)
 	if err != nil {
@@ -90,6 +94,7 @@ func createIPAssociation(d *schema.ResourceData, m interface{}) error {
 
 // getIPAssociation Get the allocated IP address/Host info
 func getIPAssociation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get IP address: %s", d.Get("ip_address").(string))
 	err := getIPAllocation(d, m)
 	if err != nil {
@@ -160,7 +165,7 @@ func deleteIPAssociation(d *schema.ResourceData, m interface{}) error {
 			properties = removeAttributeFromProperties("addresses", properties)
 			properties = fmt.Sprintf("%s|addresses=%s", properties, associateIPs)
 			log.Debugf("Association destroy properties: %s", properties)
-			_, err = objMgr.UpdateHostRecord(address.Configuration, view, zone, fqdnName, associateIPs, rrTTL, properties)
+			_, err = objMgr.UpdateHostRecord(address.Configuration, view, zone, fqdnName, associateIPs, "", rrTTL, properties)
 			if err != nil {
 				msg := fmt.Sprintf("Error updating Host record %s: %s", fqdnName, err)
 				log.Debug(msg)
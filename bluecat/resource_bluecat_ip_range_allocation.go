@@ -0,0 +1,349 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIPRangeAllocation A run of consecutive IP addresses allocated together in a network
+func ResourceIPRangeAllocation() *schema.Resource {
+	return &schema.Resource{
+		Create:        createIPRangeAllocation,
+		Read:          getIPRangeAllocation,
+		Update:        updateIPRangeAllocation,
+		Delete:        deleteIPRangeAllocation,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Configuration. Allocating the IP addresses in the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The view which contains the zone of the Host records. If not provided, the default view is used",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The zone of the Host records created for the addresses. No Host record is created if not provided",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Network address in CIDR format",
+			},
+			"ip_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "IP Address version: ipv4 or ipv6",
+			},
+			"address_count": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of consecutive IP addresses to allocate",
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					if v.(int) < 1 {
+						errs = append(errs, fmt.Errorf("%s must be at least 1, got %d", k, v.(int)))
+					}
+					return
+				},
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     entities.AllocateStatic,
+				Description: "Desired IP address state: MAKE_STATIC / MAKE_RESERVED",
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+					action := v.(string)
+					if action != entities.AllocateStatic && action != entities.AllocateReserved {
+						errs = append(errs, fmt.Errorf("%s must be %s or %s, got %q", k, entities.AllocateStatic, entities.AllocateReserved, action))
+					}
+					return
+				},
+			},
+			"name_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The template of the names of the addresses and of the Host records, %d standing for the index of the address from 1. Without %d, the index is appended",
+			},
+			"exclude_dhcp_ranges": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether or not to keep the addresses out of the DHCP ranges of the network",
+			},
+			"properties": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The properties of the Host records, or of the addresses if no zone is provided",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The allocated IP addresses, in order",
+			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the Host records",
+				Default:     "no",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the Host records",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+		},
+	}
+}
+
+// getIPRangeNames Get the names of the addresses and the FQDNs of the Host records of the run,
+// which are only created for the static addresses of a zone
+func getIPRangeNames(d *schema.ResourceData, count int) ([]string, []string) {
+	zone := d.Get("zone").(string)
+	names := getIPRunNames(d.Get("name_template").(string), count)
+	fqdnNames := make([]string, 0, len(names))
+	for i, name := range names {
+		if len(zone) > 0 && d.Get("action").(string) == entities.AllocateStatic {
+			fqdnNames = append(fqdnNames, getFQDN(name, zone))
+			names[i] = strings.TrimSuffix(strings.TrimSuffix(name, "."), "."+zone)
+		}
+	}
+	return names, fqdnNames
+}
+
+// reserveIPRun Reserve count consecutive addresses of the network: the next available address, then the ones after it.
+// When one of them is in use, the reserved ones are released and the search goes on after it
+func reserveIPRun(objMgr *utils.ObjectManager, address entities.IPAddress, network string, count int, names []string) ([]string, error) {
+	address.CIDR = strings.Split(network, "/")[0]
+	for attempt := 0; attempt < ipRangeAllocationAttempts; attempt++ {
+		next := address
+		if len(names) > 0 {
+			next.Name = names[0]
+		}
+		first, err := objMgr.CreateIPAddress(next)
+		if err != nil {
+			return nil, fmt.Errorf("Error allocating IP from network %s: %s", network, err)
+		}
+		run, err := getIPRun(network, first.Address, count)
+		if err != nil {
+			releaseIPRun(objMgr, address, []string{first.Address})
+			return nil, err
+		}
+		reserved := []string{first.Address}
+		for i := 1; i < count; i++ {
+			following := address
+			following.Address = run[i]
+			if len(names) > i {
+				following.Name = names[i]
+			}
+			if _, err = objMgr.CreateIPAddress(following); err != nil {
+				log.Debugf("The IP address %s can't be allocated: %s", run[i], err)
+				break
+			}
+			reserved = append(reserved, run[i])
+		}
+		if len(reserved) == count {
+			return run, nil
+		}
+		if err := releaseIPRun(objMgr, address, reserved); err != nil {
+			return nil, err
+		}
+		// the addresses up to the one in use can't start a run
+		address.Exclude = append(address.Exclude, fmt.Sprintf("%s-%s", run[0], run[len(reserved)]))
+	}
+	return nil, fmt.Errorf("no run of %d consecutive free addresses found in the network %s after %d attempts", count, network, ipRangeAllocationAttempts)
+}
+
+// releaseIPRun Release the reserved addresses
+func releaseIPRun(objMgr *utils.ObjectManager, address entities.IPAddress, reserved []string) error {
+	for _, ip := range reserved {
+		log.Debugf("Releasing the IP address %s", ip)
+		if _, err := objMgr.DeleteIPAddress(address.Configuration, ip, address.IPVersion); err != nil && !utils.IsNotFoundErr(err) {
+			msg := fmt.Sprintf("Releasing the IP address %s failed: %s", ip, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+	}
+	return nil
+}
+
+// createIPRangeAllocation Allocate the consecutive IP addresses and create their Host records, all of them or none
+func createIPRangeAllocation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := d.Get("zone").(string)
+	network := d.Get("network").(string)
+	count := d.Get("address_count").(int)
+	properties := d.Get("properties").(string)
+	log.Debugf("Beginning to allocate %d consecutive IP addresses in the network %s", count, network)
+
+	ipVersion := getIpVersion(d, strings.Split(network, "/")[0])
+	d.Set("ip_version", ipVersion)
+	address := entities.IPAddress{
+		Configuration:    configuration,
+		Action:           d.Get("action").(string),
+		IPVersion:        ipVersion,
+		ExcludeDHCPRange: d.Get("exclude_dhcp_ranges").(bool),
+	}
+	if len(zone) == 0 {
+		address.Properties = properties
+	}
+	names, fqdnNames := getIPRangeNames(d, count)
+
+	objMgr := GetObjManager(m)
+
+	run, err := reserveIPRun(objMgr, address, network, count, names)
+	if err != nil {
+		log.Debug(err)
+		return err
+	}
+
+	ids := make([]int, 0, len(fqdnNames))
+	for i, fqdnName := range fqdnNames {
+		log.Debugf("Creating the Host record %s", fqdnName)
+		hostRecord, err := objMgr.CreateHostRecord(configuration, view, zone, fqdnName, run[i], "", -1, properties)
+		if err != nil {
+			msg := fmt.Sprintf("Error creating the Host record %s: %s", fqdnName, err)
+			log.Debug(msg)
+			for _, created := range fqdnNames[:i] {
+				if _, err := objMgr.DeleteHostRecord(configuration, view, created); err != nil && !utils.IsNotFoundErr(err) {
+					log.Errorf("Rolling back the Host record %s failed: %s", created, err)
+				}
+			}
+			if err := releaseIPRun(objMgr, address, run); err != nil {
+				log.Error(err)
+			}
+			return fmt.Errorf(msg)
+		}
+		ids = append(ids, hostRecord.BAMId)
+	}
+
+	d.Set("addresses", run)
+	d.SetId(fmt.Sprintf("%s-%s", run[0], run[len(run)-1]))
+
+	if utils.ParseDeploymentValue(d.Get("to_deploy").(string)) && len(ids) > 0 {
+		status, err := objMgr.DeployObjects(ids, d.Get("batch_mode").(string))
+		d.Set("last_deployment_status", status)
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying the Host records of the IP addresses %s: %s", d.Id(), err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+	log.Debugf("Completed to allocate the IP addresses %s", d.Id())
+	return getIPRangeAllocation(d, m)
+}
+
+// getIPRangeAllocation Check the allocated IP addresses are still in BAM
+func getIPRangeAllocation(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	configuration := d.Get("configuration").(string)
+	ipVersion := d.Get("ip_version").(string)
+	log.Debugf("Beginning to get the IP addresses %s", d.Id())
+
+	objMgr := GetObjManager(m)
+
+	missing := make([]string, 0)
+	addresses := d.Get("addresses").([]interface{})
+	for _, item := range addresses {
+		ip := item.(string)
+		if _, err := objMgr.GetIPAddress(configuration, ip, ipVersion); err != nil {
+			if !utils.IsNotFoundErr(err) {
+				return fmt.Errorf("Getting IP address %s failed: %w", ip, err)
+			}
+			missing = append(missing, ip)
+		}
+	}
+	if len(missing) == len(addresses) && d.Id() != "" {
+		log.Warnf("IP addresses %q not found; removing from state to trigger recreation", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the IP addresses %s of the run %s were released outside Terraform", strings.Join(missing, ", "), d.Id())
+	}
+	log.Debugf("Completed reading the IP addresses %s", d.Id())
+	return nil
+}
+
+// updateIPRangeAllocation Only the deployment settings can change without allocating the addresses again
+func updateIPRangeAllocation(d *schema.ResourceData, m interface{}) error {
+	return getIPRangeAllocation(d, m)
+}
+
+// deleteIPRangeAllocation Delete the Host records and release the IP addresses
+func deleteIPRangeAllocation(d *schema.ResourceData, m interface{}) error {
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	log.Debugf("Beginning to release the IP addresses %s", d.Id())
+
+	objMgr := GetObjManager(m)
+
+	addresses := make([]string, 0)
+	for _, item := range d.Get("addresses").([]interface{}) {
+		addresses = append(addresses, item.(string))
+	}
+	_, fqdnNames := getIPRangeNames(d, len(addresses))
+	ids := make([]int, 0, len(fqdnNames))
+	for _, fqdnName := range fqdnNames {
+		hostRecord, err := objMgr.GetHostRecord(configuration, view, fqdnName)
+		if err != nil {
+			continue
+		}
+		if _, err := objMgr.DeleteHostRecord(configuration, view, fqdnName); err != nil && !utils.IsNotFoundErr(err) {
+			msg := fmt.Sprintf("Delete Host record %s failed: %s", fqdnName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		ids = append(ids, hostRecord.BAMId)
+	}
+	if utils.ParseDeploymentValue(d.Get("to_deploy").(string)) && len(ids) > 0 {
+		status, err := objMgr.DeployAndWait(ids, d.Get("batch_mode").(string))
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying the deleted Host records of the IP addresses %s: %s", d.Id(), err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+
+	address := entities.IPAddress{Configuration: configuration, IPVersion: d.Get("ip_version").(string)}
+	if err := releaseIPRun(objMgr, address, addresses); err != nil {
+		return err
+	}
+	d.SetId("")
+	log.Debugf("Completed to release the IP addresses")
+	return nil
+}
@@ -0,0 +1,321 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceMXRecord The MX record
+func ResourceMXRecord() *schema.Resource {
+	return &schema.Resource{
+		Create:        createMXRecord,
+		Read:          getMXRecord,
+		Update:        updateMXRecord,
+		Delete:        deleteMXRecord,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("absolute_name")),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Configuration. Creating the MX record in the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Zone in which you want to update a MX record. If not provided, the absolute name must be FQDN ones",
+			},
+			"absolute_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the MX record. Must be FQDN if the Zone is not provided",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					zone := d.Get("zone").(string)
+					return checkDiffName(old, new, zone)
+				},
+			},
+			"linked_record": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The mail exchanger host that the MX record links to",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					zone := d.Get("zone").(string)
+					return checkDiffName(strings.TrimSuffix(old, "."), strings.TrimSuffix(new, "."), zone)
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The preference of the mail exchanger, a lower value is a higher priority",
+				ValidateFunc: validateMXPriority,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The TTL value",
+				Default:     -1,
+			},
+			"properties": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(v interface{}) string {
+					return utils.JoinProperties(utils.ParseProperties(v.(string)))
+				},
+				DiffSuppressFunc: suppressWhenRemoteHasSuperset,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MX Records name, used exclusively for changing the name of the record. For identification, use absolute_name.",
+			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the MX record",
+				Default:     "no",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the MX record",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+			"bam_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The entity id of the resource within BAM",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: recordImporter,
+		},
+	}
+}
+
+// createMXRecord Create the new MX record
+func createMXRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
+	log.Debugf("Beginning to create MX record %s", d.Get("absolute_name"))
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := d.Get("zone").(string)
+	absoluteName := d.Get("absolute_name").(string)
+	linkedRecord := d.Get("linked_record").(string)
+	priority := d.Get("priority").(int)
+	ttl := d.Get("ttl").(int)
+	properties := d.Get("properties").(string)
+
+	objMgr := GetObjManager(m)
+
+	fqdnName := absoluteName
+
+	if len(zone) > 0 {
+		fqdnName = getFQDN(absoluteName, zone)
+	} else {
+		zone = getZoneFromRRName(fqdnName)
+	}
+
+	mxRecord, err := objMgr.CreateMXRecord(configuration, view, zone, priority, fqdnName, linkedRecord, ttl, properties)
+	if err != nil {
+		msg := fmt.Sprintf("Error creating MX record %s: %s", fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	d.SetId(fqdnName)
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", mxRecord.BAMId)
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy {
+		mxRecord.BatchMode = d.Get("batch_mode").(string)
+		status, err := objMgr.DeployObjects([]int{mxRecord.BAMId}, mxRecord.BatchMode)
+		d.Set("last_deployment_status", status)
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying MX record %s: %s", absoluteName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+	log.Debugf("Completed to create MX record %s", d.Get("absolute_name"))
+	return getMXRecord(d, m)
+}
+
+// getMXRecord Get the MX record
+func getMXRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	log.Debugf("Beginning to get MX record: %s", d.Get("absolute_name"))
+	absoluteName, err := getAbsoluteName(d)
+	if err != nil {
+		return err
+	}
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+
+	objMgr := GetObjManager(m)
+
+	mxRecord, err := objMgr.GetMXRecord(configuration, view, absoluteName)
+	if err != nil {
+		if utils.IsNotFoundErr(err) {
+			if d.Id() != "" {
+				// If the record is missing remotely, remove from state so Terraform plans a create.
+				log.Warnf("MX Record %q not found; removing from state to trigger recreation", d.Id())
+				d.SetId("")
+				return nil
+			}
+			// If we don't have an ID yet (e.g., during import resolution) surface the not-found
+			return fmt.Errorf("MX Record %s not found: %w", absoluteName, err)
+		}
+		// Any other error is a real failure
+		return fmt.Errorf("Getting MX Record %s failed: %w", absoluteName, err)
+	}
+	// --- Parse both server and config properties ---
+	bamProps := utils.ParseProperties(mxRecord.Properties)
+	cfgProps := utils.ParseProperties(d.Get("properties").(string))
+
+	// --- Filter server properties using keys from config ---
+	filteredProperties := utils.FilterProperties(bamProps, cfgProps)
+
+	d.SetId(mxRecord.AbsoluteName)
+	d.Set("absolute_name", mxRecord.AbsoluteName)
+	d.Set("bam_id", mxRecord.BAMId)
+	d.Set("properties", utils.JoinProperties(filteredProperties))
+	setMXRecordData(d, mxRecord)
+
+	log.Debugf("Completed reading MX record %s", d.Get("absolute_name"))
+	return nil
+}
+
+// updateMXRecord Update the existing MX record
+func updateMXRecord(d *schema.ResourceData, m interface{}) error {
+	log.Debugf("Beginning to update MX record %s", d.Get("absolute_name"))
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := d.Get("zone").(string)
+	absoluteName := d.Get("absolute_name").(string)
+	linkedRecord := d.Get("linked_record").(string)
+	priority := d.Get("priority").(int)
+	ttl := d.Get("ttl").(int)
+	properties := d.Get("properties").(string)
+	name := d.Get("name").(string)
+
+	objMgr := GetObjManager(m)
+
+	fqdnName := absoluteName
+
+	if len(zone) > 0 {
+		fqdnName = getFQDN(absoluteName, zone)
+	} else {
+		zone = getZoneFromRRName(fqdnName)
+	}
+
+	var immutableProperties = []string{"parentId", "parentType"} // these properties will raise error on the rest-api
+	properties = utils.RemoveImmutableProperties(properties, immutableProperties)
+
+	mxRecord, err := objMgr.UpdateMXRecord(configuration, view, zone, priority, fqdnName, linkedRecord, ttl, properties, name)
+	if err != nil {
+		msg := fmt.Sprintf("Error updating MX record %s: %s", fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy {
+		mxRecord.BatchMode = d.Get("batch_mode").(string)
+		status, err := objMgr.DeployObjects([]int{mxRecord.BAMId}, mxRecord.BatchMode)
+		d.Set("last_deployment_status", status)
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying MX record %s: %s", absoluteName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+	if name != "" {
+		fqdnName = replaceName(fqdnName, name)
+	}
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", mxRecord.BAMId)
+	d.SetId(fqdnName)
+	log.Debugf("Completed to update MX record %s", d.Get("absolute_name"))
+	return getMXRecord(d, m)
+}
+
+// deleteMXRecord Delete the MX record
+func deleteMXRecord(d *schema.ResourceData, m interface{}) error {
+	log.Debugf("Beginning to delete MX record %s", d.Get("absolute_name"))
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	absoluteName := d.Get("absolute_name").(string)
+	bamID := d.Get("bam_id").(int)
+
+	objMgr := GetObjManager(m)
+
+	_, err := objMgr.DeleteMXRecord(configuration, view, absoluteName)
+	if err != nil {
+		msg := fmt.Sprintf("Deleting MX record %s failed: %s", absoluteName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy {
+		res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying MX record %s: %s", absoluteName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", res)
+	}
+	d.SetId("")
+	log.Debugf("Completed to delete MX record %s", d.Get("absolute_name"))
+	return nil
+}
+
+// setMXRecordData Set priority and linked_record from the values kept by BAM, so an import or
+// a change outside Terraform shows up in the plan
+func setMXRecordData(d *schema.ResourceData, mxRecord *entities.MXRecord) {
+	priority := mxRecord.Priority
+	if value := utils.GetPropertyValue("priority", mxRecord.Properties); value != "" {
+		if p, err := strconv.Atoi(value); err == nil {
+			priority = p
+		}
+	}
+	d.Set("priority", priority)
+
+	linkedRecord := mxRecord.LinkedRecord
+	if value := utils.GetPropertyValue("linkedRecordName", mxRecord.Properties); value != "" {
+		linkedRecord = value
+	}
+	if linkedRecord != "" {
+		d.Set("linked_record", strings.TrimSuffix(linkedRecord, "."))
+	}
+}
+
+func validateMXPriority(v interface{}, k string) (warnings []string, errs []error) {
+	priority := v.(int)
+	if priority < 0 || priority > 65535 {
+		errs = append(errs, fmt.Errorf("%s must be between 0 and 65535, got %d", k, priority))
+	}
+	return
+}
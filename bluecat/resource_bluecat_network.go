@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"reflect"
 	"strconv"
 	"strings"
@@ -23,21 +24,32 @@ func ResourceNetwork() *schema.Resource {
 		Read:   getNetwork,
 		Update: updateNetwork,
 		Delete: deleteNetwork,
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration"), func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			// Next-available mode resolves cidr during Create, so mark as computed at plan time.
 			cidr := d.Get("cidr").(string)
 			parentBlock := d.Get("parent_block").(string)
 			size := d.Get("size").(string)
 			if cidr == "" && parentBlock != "" && size != "" {
+				ipVersion := d.Get("ip_version").(string)
+				if ipVersion == "" {
+					ipVersion = entities.IPV4
+					if ip := net.ParseIP(strings.Split(parentBlock, "/")[0]); ip != nil && ip.To4() == nil {
+						ipVersion = entities.IPV6
+					}
+				}
+				if err := validateNextNetworkSize(size, ipVersion, parentBlock); err != nil {
+					return err
+				}
 				d.SetNewComputed("cidr")
 			}
 			return nil
-		},
+		}),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the Network in the default Configuration if doesn't specify",
 			},
 			"name": {
@@ -73,6 +85,12 @@ func ResourceNetwork() *schema.Resource {
 				Description: "The deployment options for the network.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"ignore_unmanaged_options": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not to ignore the deployment options on all the servers which are not in deployment_options, instead of showing them as drift",
+			},
 			"properties": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -94,7 +112,7 @@ func ResourceNetwork() *schema.Resource {
 			"size": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The size of the network expressed in the power of 2",
+				Description: "The size of the next available network: the number of addresses, a power of 2, for IPv4 and the prefix length, such as 64, for IPv6",
 			},
 			"allocated_id": {
 				Type:        schema.TypeString,
@@ -109,6 +127,23 @@ func ResourceNetwork() *schema.Resource {
 				Optional:    true,
 				Description: "Network IP version: ipv4 or ipv6",
 			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the Network to the DHCP servers",
+				Default:     "no",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the Network",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			State: resourceImporter,
@@ -118,6 +153,7 @@ func ResourceNetwork() *schema.Resource {
 
 // createNetwork Create the new IPv4/IPv6 Network
 func createNetwork(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 
 	objMgr := GetObjManager(m)
 
@@ -164,8 +200,10 @@ func createNetwork(d *schema.ResourceData, m interface{}) error {
 		}
 
 		log.Debugf("Successful to create Network %s", network.CIDR)
+		// save the network right away: a failed deployment leaves it tainted in the state
+		d.SetId(network.CIDR)
 
-	} else if network.IPVersion != entities.IPV6 {
+	} else {
 		// Create next available network
 
 		if network.ParentBlock == "" {
@@ -174,13 +212,6 @@ func createNetwork(d *schema.ResourceData, m interface{}) error {
 			return fmt.Errorf(msg)
 		}
 
-		sizeNumber, err := strconv.Atoi(network.Size)
-		if err != nil || !isPowerOfTwo(sizeNumber) {
-			msg := "'size' is a required property and must be power of 2 to get next available network"
-			log.Error(msg)
-			return fmt.Errorf(msg)
-		}
-
 		// Keep the mask the user supplied in parent_block; deriving it from the
 		// block GET response is unreliable, which yields a /0 subnet.
 		parts := strings.Split(network.ParentBlock, "/")
@@ -194,26 +225,54 @@ func createNetwork(d *schema.ResourceData, m interface{}) error {
 		if len(parts) == 2 {
 			blockCIDR = parts[1]
 		}
+		if d.Get("ip_version").(string) == "" {
+			network.IPVersion = getIpVersion(d, blockAddress)
+		}
+		if err := validateNextNetworkSize(network.Size, network.IPVersion, network.ParentBlock); err != nil {
+			msg := fmt.Sprintf("'size' is a required property to get next available network: %s", err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
 		network.ParentBlock = blockAddress
-		_, err = objMgr.GetBlock(network.Configuration, blockAddress, blockCIDR, network.IPVersion)
+		_, err := objMgr.GetBlock(network.Configuration, blockAddress, blockCIDR, network.IPVersion)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to getting the IPv4 Block for (%s): %s", network.CIDR, err)
+			msg := fmt.Sprintf("Failed to getting the Block for (%s): %s", network.CIDR, err)
 			log.Error(msg)
 			return fmt.Errorf(msg)
 		}
 		network.BlockAddr = fmt.Sprintf("%s/%s", blockAddress, blockCIDR)
 
-		_, ref, err := objMgr.CreateNextAvailableNetwork(network)
-		if err != nil {
-			msg := fmt.Sprintf("Error creating next available Network of Block(%s): %s", network.ParentBlock, err)
-			log.Error(msg)
-			return fmt.Errorf(msg)
+		if network.AllocatedId != "" {
+			// a previous attempt may have created the network but failed afterwards
+			allocated, err := objMgr.GetNetworkByAllocatedId(network.Configuration, network.BlockAddr, network.AllocatedId, network.IPVersion)
+			if err == nil && allocated.CIDR != "" {
+				log.Debugf("Found the Network %s allocated with the id %s", allocated.CIDR, network.AllocatedId)
+				network.CIDR = allocated.CIDR
+			} else if err != nil && !utils.IsNotFoundErr(err) {
+				// creating another network would duplicate the one the id may still hold
+				msg := fmt.Sprintf("Getting the Network by the allocated id %s failed: %s", network.AllocatedId, err)
+				log.Error(msg)
+				return fmt.Errorf(msg)
+			}
 		}
 
-		log.Debugf("Successful to create next available Network of Block %s", d.Get("parent_block"))
+		if network.CIDR == "" {
+			_, ref, err := objMgr.CreateNextAvailableNetwork(network)
+			if err != nil {
+				msg := fmt.Sprintf("Error creating next available Network of Block(%s): %s", network.ParentBlock, err)
+				log.Error(msg)
+				return fmt.Errorf(msg)
+			}
 
-		network.CIDR = getObjectFieldValue("CIDR", ref)
+			log.Debugf("Successful to create next available Network of Block %s", d.Get("parent_block"))
+			network.CIDR = getObjectFieldValue("CIDR", ref)
+		}
 		d.Set("cidr", network.CIDR)
+		if network.IPVersion == entities.IPV6 {
+			d.Set("ip_version", network.IPVersion)
+		}
+		// save the network right away: a failure below leaves it in the state
+		d.SetId(network.CIDR)
 
 		networkAddress = strings.Split(network.CIDR, "/")[0]
 	}
@@ -242,11 +301,18 @@ func createNetwork(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("creating deployment options on Network (%s) failed: %w", network.CIDR, err)
 	}
+	err = deployDHCPObject(d, objMgr, network.IPVersion, fmt.Sprintf("Network %s", network.CIDR), func() (int, error) {
+		return getNetworkBAMID(objMgr, network)
+	})
+	if err != nil {
+		return err
+	}
 	return getNetwork(d, m)
 }
 
 // getNetwork Get the IPv4/IPv6 Network
 func getNetwork(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 
 	objMgr := GetObjManager(m)
 
@@ -307,7 +373,7 @@ func getNetwork(d *schema.ResourceData, m interface{}) error {
 			return fmt.Errorf("Getting Network %s failed: %w", cidr, getNetworkError)
 		}
 	} else if allocatedId != "" && parentBlock != "" {
-		network, err = objMgr.GetNetworkByAllocatedId(configuration, parentBlock, allocatedId)
+		network, err = objMgr.GetNetworkByAllocatedId(configuration, parentBlock, allocatedId, ipVersion)
 		if err != nil {
 			msg := fmt.Sprintf("Getting Network in block %s failed: %s", parentBlock, err)
 			log.Error(msg)
@@ -321,12 +387,12 @@ func getNetwork(d *schema.ResourceData, m interface{}) error {
 
 	// --- Filter server properties using keys from config ---
 	filteredProperties := utils.FilterProperties(bamProps, cfgProps)
-	deploymentOptions, err := utils.ReadDeploymentOptions(objMgr, entities.DeploymentOption{
+	deploymentOptions, err := utils.ListDeploymentOptions(objMgr, entities.DeploymentOption{
 		Configuration: configuration,
 		ResourceType:  "network",
 		ResourceRef:   network.CIDR,
 		IPVersion:     network.IPVersion,
-	}, utils.ExpandStringMap(d.Get("deployment_options")))
+	}, utils.ExpandStringMap(d.Get("deployment_options")), d.Get("ignore_unmanaged_options").(bool))
 	if err != nil {
 		return fmt.Errorf("getting deployment options on Network %s failed: %w", network.CIDR, err)
 	}
@@ -367,6 +433,12 @@ func updateNetwork(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("updating deployment options on Network (%s) failed: %w", network.CIDR, err)
 	}
+	err = deployDHCPObject(d, objMgr, network.IPVersion, fmt.Sprintf("Network %s", network.CIDR), func() (int, error) {
+		return getNetworkBAMID(objMgr, network)
+	})
+	if err != nil {
+		return err
+	}
 	log.Debugf("Completed to update Network %s", network.CIDR)
 	return getNetwork(d, m)
 }
@@ -394,10 +466,51 @@ func deleteNetwork(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// getNetworkBAMID Get the BAM ID of the Network
+func getNetworkBAMID(objMgr *utils.ObjectManager, network entities.Network) (int, error) {
+	networkEntity, err := objMgr.GetNetwork(&network)
+	if err != nil {
+		return 0, err
+	}
+	return networkEntity.NetWorkId, nil
+}
+
 func isPowerOfTwo(number int) bool {
 	return (number != 0) && (number&(number-1) == 0)
 }
 
+// validateNextNetworkSize Check the size of the next available network in the parent block. The size of an IPv4
+// network is its number of addresses, a power of 2; the size of an IPv6 network is its prefix length, such as 64,
+// its number of addresses being too large to be given
+func validateNextNetworkSize(size string, ipVersion string, parentBlock string) error {
+	parentPrefix := 0
+	if parts := strings.Split(parentBlock, "/"); len(parts) == 2 {
+		prefix, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid prefix length of the parent block %q", parentBlock)
+		}
+		parentPrefix = prefix
+	}
+	if ipVersion == entities.IPV6 {
+		prefix, err := strconv.Atoi(strings.TrimPrefix(size, "/"))
+		if err != nil || prefix < 1 || prefix > 128 {
+			return fmt.Errorf("the size of an IPv6 network is its prefix length between 1 and 128, got %q", size)
+		}
+		if prefix <= parentPrefix {
+			return fmt.Errorf("the prefix length %d of the IPv6 network must be longer than the one of the parent block %s", prefix, parentBlock)
+		}
+		return nil
+	}
+	number, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || number <= 0 || number > 1<<32 || number&(number-1) != 0 {
+		return fmt.Errorf("the size of an IPv4 network is its number of addresses, a power of 2, got %q", size)
+	}
+	if parentPrefix > 0 && parentPrefix <= 32 && number > int64(1)<<(32-parentPrefix) {
+		return fmt.Errorf("the network of %d addresses doesn't fit in the parent block %s", number, parentBlock)
+	}
+	return nil
+}
+
 func getObjectFieldValue(fieldName, ref string) (val string) {
 
 	object := entities.Network{}
@@ -13,20 +13,23 @@ import (
 // ResourcePTRRecord The PTR record
 func ResourcePTRRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: createPTRRecord,
-		Read:   getPTRRecord,
-		Update: updatePTRRecord,
-		Delete: deletePTRRecord,
+		Create:        createPTRRecord,
+		Read:          getPTRRecord,
+		Update:        updatePTRRecord,
+		Delete:        deletePTRRecord,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the PTR record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
 			},
 			"zone": {
@@ -73,6 +76,11 @@ func ResourcePTRRecord() *schema.Resource {
 				Description: "Whether or not to selectively deploy the Host record",
 				Default:     "no",
 			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the PTR record",
+			},
 			"batch_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -86,6 +94,7 @@ func ResourcePTRRecord() *schema.Resource {
 // createPTRRecord Create the new PTR record
 // Create the Host record, then server will create the PTR
 func createPTRRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to create PTR record %s", d.Get("name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -97,7 +106,10 @@ func createPTRRecord(d *schema.ResourceData, m interface{}) error {
 	properties := d.Get("properties").(string)
 	to_deploy := d.Get("to_deploy").(string)
 	batch_mode := d.Get("batch_mode").(string)
-	fqdnName, err := updatePTR(m, configuration, view, zone, name, ipAddress, reverseRecord, properties, ttl, to_deploy, batch_mode)
+	fqdnName, deploymentStatus, err := updatePTR(m, configuration, view, zone, name, ipAddress, reverseRecord, properties, ttl, to_deploy, batch_mode)
+	if deploymentStatus != "" {
+		d.Set("last_deployment_status", deploymentStatus)
+	}
 	if err != nil {
 		return err
 	}
@@ -108,6 +120,7 @@ func createPTRRecord(d *schema.ResourceData, m interface{}) error {
 
 // getPTRRecord Get the PTR record
 func getPTRRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get PTR record: %s", d.Get("name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -144,7 +157,10 @@ func updatePTRRecord(d *schema.ResourceData, m interface{}) error {
 	to_deploy := d.Get("to_deploy").(string)
 	batch_mode := d.Get("batch_mode").(string)
 
-	fqdnName, err := updatePTR(m, configuration, view, zone, name, ipAddress, reverseRecord, properties, ttl, to_deploy, batch_mode)
+	fqdnName, deploymentStatus, err := updatePTR(m, configuration, view, zone, name, ipAddress, reverseRecord, properties, ttl, to_deploy, batch_mode)
+	if deploymentStatus != "" {
+		d.Set("last_deployment_status", deploymentStatus)
+	}
 	if err != nil {
 		return err
 	}
@@ -155,7 +171,7 @@ func updatePTRRecord(d *schema.ResourceData, m interface{}) error {
 
 // updatePTRRecord Update the existing PTR record
 // Update the PTR, just set the reverseRecord flag
-func updatePTR(m interface{}, configuration, view, zone, name, ip4Address, reverseRecord, properties string, ttl int, to_deploy string, batch_mode string) (string, error) {
+func updatePTR(m interface{}, configuration, view, zone, name, ip4Address, reverseRecord, properties string, ttl int, to_deploy string, batch_mode string) (string, string, error) {
 	connector := m.(*utils.Connector)
 	objMgr := new(utils.ObjectManager)
 	objMgr.Connector = connector
@@ -168,11 +184,19 @@ func updatePTR(m interface{}, configuration, view, zone, name, ip4Address, rever
 	}
 
 	// Get the host
-	_, err := objMgr.GetHostRecord(configuration, view, fqdnName)
+	host, err := objMgr.GetHostRecord(configuration, view, fqdnName)
 	if err != nil {
 		msg := fmt.Sprintf("Getting Host record %s failed: %s", fqdnName, err)
 		log.Debug(msg)
-		return "", fmt.Errorf(msg)
+		return "", "", fmt.Errorf(msg)
+	}
+	// Keep the other addresses of a multi-address host
+	hostAddresses := parseRecordPropertyValue(host.Properties, "addresses")
+	for _, address := range splitHostAddresses(hostAddresses) {
+		if address == normalizeIPAddress(ip4Address) {
+			ip4Address = hostAddresses
+			break
+		}
 	}
 
 	// Update the host record
@@ -187,30 +211,31 @@ func updatePTR(m interface{}, configuration, view, zone, name, ip4Address, rever
 	} else {
 		msg := fmt.Sprintf("invalid reverse_record value (must be either 'true' or 'false'): '%s'", reverseRecord)
 		log.Debug(msg)
-		return "", fmt.Errorf(msg)
+		return "", "", fmt.Errorf(msg)
 	}
 
 	var immutableProperties = []string{"parentId", "parentType"} // these properties will raise error on the rest-api
 	properties = utils.RemoveImmutableProperties(properties, immutableProperties)
 
-	hostRecord, err := objMgr.UpdateHostRecord(configuration, view, zone, fqdnName, ip4Address, ttl, properties)
+	hostRecord, err := objMgr.UpdateHostRecord(configuration, view, zone, fqdnName, ip4Address, "", ttl, properties)
 	if err != nil {
 		msg := fmt.Sprintf("Error updating PTR record %s: %s", fqdnName, err)
 		log.Debug(msg)
-		return "", fmt.Errorf(msg)
+		return "", "", fmt.Errorf(msg)
 	}
 	deploy := utils.ParseDeploymentValue(to_deploy)
 	if deploy {
 		hostRecord.BatchMode = batch_mode
-		res, err := objMgr.Connector.DeployObject([]int{hostRecord.BAMId}, hostRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{hostRecord.BAMId}, hostRecord.BatchMode)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying PTR record %s: %s", fqdnName, err)
 			log.Debug(msg)
-			return fqdnName, fmt.Errorf(msg)
+			return fqdnName, status, fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
+		return fqdnName, status, nil
 	}
-	return fqdnName, nil
+	return fqdnName, "", nil
 }
 
 // deletePTRRecord Delete the PTR record
@@ -228,7 +253,7 @@ func deletePTRRecord(d *schema.ResourceData, m interface{}) error {
 	to_deploy := d.Get("to_deploy").(string)
 	batch_mode := d.Get("batch_mode").(string)
 
-	_, err := updatePTR(m, configuration, view, zone, name, ipAddress, reverseRecord, properties, ttl, to_deploy, batch_mode)
+	_, _, err := updatePTR(m, configuration, view, zone, name, ipAddress, reverseRecord, properties, ttl, to_deploy, batch_mode)
 	if err != nil {
 		return err
 	}
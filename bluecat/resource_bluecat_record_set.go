@@ -0,0 +1,309 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceRecordSet All the records of a name and a type, managed authoritatively
+func ResourceRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create:        createRecordSet,
+		Read:          getRecordSet,
+		Update:        updateRecordSet,
+		Delete:        deleteRecordSet,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("name")),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Configuration. Managing the records in the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The view which contains the details of the zone. If not provided, the records will be managed under default view",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Zone of the records. If not provided, the name must be FQDN one",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the records. Must be FQDN if the Zone is not provided",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					zone := d.Get("zone").(string)
+					return checkDiffName(old, new, zone)
+				},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the records, such as A, AAAA, MX or TXT",
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
+			},
+			"values": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The data of the records. Any other record of the name and the type is deleted",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The TTL value of the records",
+				Default:     -1,
+			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the records created and deleted",
+				Default:     "no",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the records",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+			"record_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The entity ids of the records within BAM, by value",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: recordSetImporter,
+		},
+	}
+}
+
+// recordSetImporter Import the record set by its ID, the FQDN and the type joined by a slash
+func recordSetImporter(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	name, typerr, err := recordSetParseId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("name", name)
+	d.Set("type", typerr)
+	d.Set("zone", getZoneFromRRName(name))
+	d.SetId(fmt.Sprintf("%s/%s", name, typerr))
+	return []*schema.ResourceData{d}, nil
+}
+
+func recordSetParseId(id string) (string, string, error) {
+	index := strings.LastIndex(id, "/")
+	if index <= 0 || index == len(id)-1 || !strings.Contains(id[:index], ".") {
+		return "", "", fmt.Errorf("unexpected format of record set ID (%s), expected name.zone/type", id)
+	}
+	return id[:index], strings.ToUpper(id[index+1:]), nil
+}
+
+// getRecordSetName Get the FQDN and the zone of the record set
+func getRecordSetName(d *schema.ResourceData) (string, string) {
+	fqdnName := d.Get("name").(string)
+	zone := d.Get("zone").(string)
+	if len(zone) > 0 {
+		fqdnName = getFQDN(fqdnName, zone)
+	} else {
+		zone = getZoneFromRRName(fqdnName)
+	}
+	return fqdnName, zone
+}
+
+// createRecordSet Create the records of the set, deleting the records of the name and the type which aren't in it
+func createRecordSet(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "name")
+	fqdnName, zone := getRecordSetName(d)
+	typerr := strings.ToUpper(d.Get("type").(string))
+	log.Debugf("Beginning to create %s record set %s", typerr, fqdnName)
+	if err := reconcileRecordSet(d, m); err != nil {
+		return err
+	}
+	d.Set("name", fqdnName)
+	d.Set("zone", zone)
+	d.SetId(fmt.Sprintf("%s/%s", fqdnName, typerr))
+	log.Debugf("Completed to create %s record set %s", typerr, fqdnName)
+	return getRecordSet(d, m)
+}
+
+// getRecordSet Get the records of the name and the type
+func getRecordSet(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	fqdnName, zone := getRecordSetName(d)
+	typerr := strings.ToUpper(d.Get("type").(string))
+	log.Debugf("Beginning to get %s record set %s", typerr, fqdnName)
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+
+	objMgr := GetObjManager(m)
+
+	records, _, err := utils.ListResourceRecords(objMgr, configuration, view, zone, fqdnName, typerr)
+	if err != nil {
+		msg := fmt.Sprintf("Getting %s record set %s failed: %s", typerr, fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+
+	configured := make([]string, 0)
+	for _, value := range d.Get("values").(*schema.Set).List() {
+		configured = append(configured, value.(string))
+	}
+	values := readRecordSetValues(typerr, configured, records)
+	ids := make(map[string]interface{}, len(records))
+	for i, record := range records {
+		ids[values[i]] = record.BAMId
+	}
+
+	d.Set("type", typerr)
+	d.Set("values", values)
+	d.Set("ttl", readRecordSetTTL(d.Get("ttl").(int), records))
+	d.Set("record_ids", ids)
+	log.Debugf("Completed reading %s record set %s: %d records", typerr, fqdnName, len(records))
+	return nil
+}
+
+// updateRecordSet Create the missing records of the set and delete the extra ones
+func updateRecordSet(d *schema.ResourceData, m interface{}) error {
+	fqdnName, _ := getRecordSetName(d)
+	log.Debugf("Beginning to update %s record set %s", d.Get("type"), fqdnName)
+	if err := reconcileRecordSet(d, m); err != nil {
+		return err
+	}
+	log.Debugf("Completed to update %s record set %s", d.Get("type"), fqdnName)
+	return getRecordSet(d, m)
+}
+
+// reconcileRecordSet Make BAM hold exactly the configured records of the name and the type
+func reconcileRecordSet(d *schema.ResourceData, m interface{}) error {
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	fqdnName, zone := getRecordSetName(d)
+	typerr := strings.ToUpper(d.Get("type").(string))
+	ttl := d.Get("ttl").(int)
+	values := make([]string, 0)
+	for _, value := range d.Get("values").(*schema.Set).List() {
+		values = append(values, value.(string))
+	}
+
+	objMgr := GetObjManager(m)
+
+	existing, byName, err := utils.ListResourceRecords(objMgr, configuration, view, zone, fqdnName, typerr)
+	if err != nil {
+		msg := fmt.Sprintf("Getting %s record set %s failed: %s", typerr, fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	if byName && len(values) > 1 {
+		msg := fmt.Sprintf("%s record set %s has %d values but the Gateway has no resource_records endpoints, which are needed to manage more than one record of a name", typerr, fqdnName, len(values))
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	create, remove := planRecordSet(typerr, values, ttl, existing)
+	log.Debugf("%s record set %s: creating %v, deleting %d records", typerr, fqdnName, create, len(remove))
+
+	ids := make([]int, 0, len(create)+len(remove))
+	if err := utils.DeleteResourceRecords(objMgr, configuration, view, fqdnName, remove, byName); err != nil {
+		msg := fmt.Sprintf("Error deleting %d %s records %s: %s", len(remove), typerr, fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	for _, record := range remove {
+		ids = append(ids, record.BAMId)
+	}
+	for _, value := range create {
+		record, err := objMgr.CreateGenericRecord(configuration, view, zone, typerr, fqdnName, value, ttl, "")
+		if err != nil {
+			msg := fmt.Sprintf("Error creating %s record %s with data %s: %s", typerr, fqdnName, value, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		ids = append(ids, record.BAMId)
+	}
+
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy && len(ids) > 0 {
+		batchMode := d.Get("batch_mode").(string)
+		var status string
+		if len(remove) > 0 {
+			// the deleted records can't wait in the deployment queue
+			status, err = objMgr.DeployAndWait(ids, batchMode)
+		} else {
+			status, err = objMgr.DeployObjects(ids, batchMode)
+		}
+		d.Set("last_deployment_status", status)
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying %s record set %s: %s", typerr, fqdnName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+	return nil
+}
+
+// deleteRecordSet Delete all the records of the name and the type
+func deleteRecordSet(d *schema.ResourceData, m interface{}) error {
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	fqdnName, zone := getRecordSetName(d)
+	typerr := strings.ToUpper(d.Get("type").(string))
+	log.Debugf("Beginning to delete %s record set %s", typerr, fqdnName)
+
+	objMgr := GetObjManager(m)
+
+	records, byName, err := utils.ListResourceRecords(objMgr, configuration, view, zone, fqdnName, typerr)
+	if err != nil {
+		msg := fmt.Sprintf("Getting %s record set %s failed: %s", typerr, fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	if err := utils.DeleteResourceRecords(objMgr, configuration, view, fqdnName, records, byName); err != nil {
+		msg := fmt.Sprintf("Error deleting %s record set %s: %s", typerr, fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	ids := make([]int, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.BAMId)
+	}
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy && len(ids) > 0 {
+		res, err := objMgr.DeployAndWait(ids, d.Get("batch_mode").(string))
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying %s record set %s: %s", typerr, fqdnName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", res)
+	}
+	d.SetId("")
+	log.Debugf("Completed to delete %s record set %s", typerr, fqdnName)
+	return nil
+}
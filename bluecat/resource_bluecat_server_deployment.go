@@ -0,0 +1,149 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var serverDeploymentTypes = []string{"full", "quick"}
+var serverDeploymentServices = []string{entities.DeployServiceDNS, entities.DeployServiceDHCP, entities.DeployServiceDHCPv6}
+
+// ResourceServerDeployment Runs a full or quick deployment of the servers
+func ResourceServerDeployment() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        createServerDeployment,
+		Read:          getServerDeployment,
+		Delete:        deleteServerDeployment,
+		CustomizeDiff: setProviderDefaultsDiff("configuration"),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Configuration of the servers. Using the default Configuration if doesn't specify",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The FQDNs of the servers to deploy",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "full",
+				Description:  "The type of the deployment: full or quick",
+				ValidateFunc: validation.StringInSlice(serverDeploymentTypes, true),
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      entities.DeployServiceDNS,
+				Description:  "The service to deploy: DNS, DHCP or DHCPv6",
+				ValidateFunc: validation.StringInSlice(serverDeploymentServices, true),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values which trigger a new deployment when changed",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the deployment, FAILED if any of the servers failed. SUBMITTED if the provider couldn't wait for the result",
+			},
+			"server_deployment_status": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The status of the deployment per server FQDN",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// createServerDeployment Deploy each of the servers
+func createServerDeployment(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
+	configuration := d.Get("configuration").(string)
+	deploymentType, ok := matchFold(serverDeploymentTypes, d.Get("deployment_type").(string))
+	if !ok {
+		return fmt.Errorf("unknown deployment_type %s, expected one of %s", d.Get("deployment_type"), strings.Join(serverDeploymentTypes, ", "))
+	}
+	service, ok := matchFold(serverDeploymentServices, d.Get("service").(string))
+	if !ok {
+		return fmt.Errorf("unknown service %s, expected one of %s", d.Get("service"), strings.Join(serverDeploymentServices, ", "))
+	}
+	log.Debugf("Beginning to run the %s %s deployment", deploymentType, service)
+
+	objMgr := GetObjManager(m)
+
+	serverStatus := make(map[string]string)
+	failures := make([]string, 0)
+	lastStatus := utils.DeploymentSucceeded
+	for _, server := range d.Get("servers").([]interface{}) {
+		serverFQDN := server.(string)
+		status, err := objMgr.DeployServer(configuration, serverFQDN, deploymentType, service)
+		if status == "" {
+			status = utils.DeploymentFailed
+		}
+		serverStatus[serverFQDN] = status
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", serverFQDN, err))
+			lastStatus = status
+			continue
+		}
+		if lastStatus == utils.DeploymentSucceeded {
+			lastStatus = status
+		}
+	}
+	// Keep the outcome in the state even if a server failed, the resource is tainted and deploys again on the next apply
+	d.SetId(id.UniqueId())
+	d.Set("last_deployment_status", lastStatus)
+	d.Set("server_deployment_status", serverStatus)
+	if len(failures) > 0 {
+		msg := fmt.Sprintf("Error running the %s %s deployment: %s", deploymentType, service, strings.Join(failures, "; "))
+		log.Error(msg)
+		return fmt.Errorf(msg)
+	}
+	log.Debugf("Completed to run the %s %s deployment", deploymentType, service)
+	return getServerDeployment(d, m)
+}
+
+// getServerDeployment The deployment only lives in the state, nothing to read back from BAM
+func getServerDeployment(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
+	return nil
+}
+
+// deleteServerDeployment Remove the deployment from the state, the servers are not affected
+func deleteServerDeployment(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// matchFold Find the value in the list ignoring the case, and return it as spelled in the list
+func matchFold(values []string, value string) (string, bool) {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return v, true
+		}
+	}
+	return "", false
+}
@@ -13,25 +13,29 @@ import (
 // ResourceSRVRecord The SRV record
 func ResourceSRVRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: createSRVRecord,
-		Read:   getSRVRecord,
-		Update: updateSRVRecord,
-		Delete: deleteSRVRecord,
+		Create:        createSRVRecord,
+		Read:          getSRVRecord,
+		Update:        updateSRVRecord,
+		Delete:        deleteSRVRecord,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("absolute_name")),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the SRV record in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
 			},
 			"zone": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Zone in which you want to update a SRV record. If not provided, the absolute name must be FQDN ones",
 			},
 			"absolute_name": {
@@ -92,6 +96,11 @@ func ResourceSRVRecord() *schema.Resource {
 				Description: "Whether or not to selectively deploy the SRV record",
 				Default:     "no",
 			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the SRV record",
+			},
 			"batch_mode": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -112,6 +121,8 @@ func ResourceSRVRecord() *schema.Resource {
 
 // createSRVRecord Create the new SRV record
 func createSRVRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
 	log.Debugf("Beginning to create SRV record %s", d.Get("absolute_name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -142,25 +153,28 @@ func createSRVRecord(d *schema.ResourceData, m interface{}) error {
 		log.Debug(msg)
 		return fmt.Errorf(msg)
 	}
+	d.SetId(fqdnName)
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", srvRecord.BAMId)
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		srvRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{srvRecord.BAMId}, srvRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{srvRecord.BAMId}, srvRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying SRV record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
-	d.Set("absolute_name", fqdnName)
-	d.Set("bam_id", srvRecord.BAMId)
 	log.Debugf("Completed to create SRV record %s", d.Get("absolute_name"))
 	return getSRVRecord(d, m)
 }
 
 // getSRVRecord Get the SRV record
 func getSRVRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get SRV record: %s", d.Get("absolute_name"))
 	absoluteName, err := getAbsoluteName(d)
 	configuration := d.Get("configuration").(string)
@@ -241,13 +255,14 @@ func updateSRVRecord(d *schema.ResourceData, m interface{}) error {
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		srvRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{srvRecord.BAMId}, srvRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{srvRecord.BAMId}, srvRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying SRV record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
 	if name != "" {
 		fqdnName = replaceName(fqdnName, name)
@@ -279,7 +294,7 @@ func deleteSRVRecord(d *schema.ResourceData, m interface{}) error {
 	}
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
-		res, err := objMgr.Connector.DeployObject([]int{bamID}, d.Get("batch_mode").(string))
+		res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying SRV record %s: %s", absoluteName, err)
 			log.Debug(msg)
@@ -3,6 +3,7 @@
 package bluecat
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-bluecat/bluecat/utils"
 
@@ -12,75 +13,19 @@ import (
 // ResourceTXTRecord The TXT record
 func ResourceTXTRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: createTXTRecord,
-		Read:   getTXTRecord,
-		Update: updateTXTRecord,
-		Delete: deleteTXTRecord,
-
-		Schema: map[string]*schema.Schema{
-			"configuration": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The Configuration. Creating the TXT record in the default Configuration if doesn't specify",
-			},
-			"view": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
-			},
-			"zone": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The Zone in which you want to update a TXT record. If not provided, the absolute name must be FQDN ones",
-			},
-			"absolute_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the TXT record. Must be FQDN if the Zone is not provided",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					zone := d.Get("zone").(string)
-					return checkDiffName(old, new, zone)
-				},
-			},
-			"text": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Set the text of TXT record",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					zone := d.Get("zone").(string)
-					return checkDiffName(old, new, zone)
-				},
-			},
-			"ttl": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "The TTL value",
-				Default:     -1,
-			},
-			"properties": {
-				Type:     schema.TypeString,
-				Optional: true,
-				StateFunc: func(v interface{}) string {
-					return utils.JoinProperties(utils.ParseProperties(v.(string)))
-				},
-				DiffSuppressFunc: suppressWhenRemoteHasSuperset,
-			},
-			"to_deploy": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Whether or not to selectively deploy the TXT record",
-				Default:     "no",
-			},
-			"batch_mode": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Whether or not to use batch mode when selectively deploying",
-				Default:     "disabled",
-			},
-			"bam_id": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "The entity id of the resource within BAM",
+		Create:        createTXTRecord,
+		Read:          getTXTRecord,
+		Update:        updateTXTRecord,
+		Delete:        deleteTXTRecord,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("absolute_name"), validateTXTStringsDiff),
+
+		Schema:        txtRecordSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceTXTRecordV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeTXTRecordStateV0,
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -89,14 +34,121 @@ func ResourceTXTRecord() *schema.Resource {
 	}
 }
 
+// resourceTXTRecordV0 The TXT record before text became a list of strings
+func resourceTXTRecordV0() *schema.Resource {
+	recordSchema := txtRecordSchema()
+	delete(recordSchema, "auto_split")
+	recordSchema["text"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	return &schema.Resource{
+		Schema: recordSchema,
+	}
+}
+
+// upgradeTXTRecordStateV0 Turn the text string into the list of its character-strings
+func upgradeTXTRecordStateV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if text, ok := rawState["text"].(string); ok {
+		texts := make([]interface{}, 0)
+		for _, str := range parseTXTData(text) {
+			texts = append(texts, str)
+		}
+		rawState["text"] = texts
+	}
+	return rawState, nil
+}
+
+func txtRecordSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"configuration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The Configuration. Creating the TXT record in the default Configuration if doesn't specify",
+		},
+		"view": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
+		},
+		"zone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The Zone in which you want to update a TXT record. If not provided, the absolute name must be FQDN ones",
+		},
+		"absolute_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the TXT record. Must be FQDN if the Zone is not provided",
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				zone := d.Get("zone").(string)
+				return checkDiffName(old, new, zone)
+			},
+		},
+		"text": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "The texts of the TXT record, each one stored as its own character-string",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"auto_split": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether or not to split the texts longer than 255 bytes into several character-strings",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The TTL value",
+			Default:     -1,
+		},
+		"properties": {
+			Type:     schema.TypeString,
+			Optional: true,
+			StateFunc: func(v interface{}) string {
+				return utils.JoinProperties(utils.ParseProperties(v.(string)))
+			},
+			DiffSuppressFunc: suppressWhenRemoteHasSuperset,
+		},
+		"to_deploy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Whether or not to selectively deploy the TXT record",
+			Default:     "no",
+		},
+		"last_deployment_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the last selective deployment of the TXT record",
+		},
+		"batch_mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Whether or not to use batch mode when selectively deploying",
+			Default:     "disabled",
+		},
+		"bam_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The entity id of the resource within BAM",
+		},
+	}
+}
+
 // createTXTRecord Create the new TXT record
 func createTXTRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
 	log.Debugf("Beginning to create TXT record %s", d.Get("absolute_name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
 	zone := d.Get("zone").(string)
 	absoluteName := d.Get("absolute_name").(string)
-	text := d.Get("text").(string)
 	ttl := d.Get("ttl").(int)
 	properties := d.Get("properties").(string)
 
@@ -112,31 +164,41 @@ func createTXTRecord(d *schema.ResourceData, m interface{}) error {
 		zone = getZoneFromRRName(fqdnName)
 	}
 
+	text, err := getTXTData(d)
+	if err != nil {
+		msg := fmt.Sprintf("Error creating TXT record %s: %s", fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+
 	txtRecord, err := objMgr.CreateTXTRecord(configuration, view, zone, fqdnName, text, ttl, properties)
 	if err != nil {
 		msg := fmt.Sprintf("Error creating TXT record %s: %s", fqdnName, err)
 		log.Debug(msg)
 		return fmt.Errorf(msg)
 	}
+	d.SetId(fqdnName)
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", txtRecord.BAMId)
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		txtRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{txtRecord.BAMId}, txtRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{txtRecord.BAMId}, txtRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying TXT record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
-	d.Set("absolute_name", fqdnName)
-	d.Set("bam_id", txtRecord.BAMId)
 	log.Debugf("Completed to create TXT record %s", d.Get("absolute_name"))
 	return getTXTRecord(d, m)
 }
 
 // getTXTRecord Get the TXT record
 func getTXTRecord(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get TXT record: %s", d.Get("absolute_name"))
 	absoluteName, err := getAbsoluteName(d)
 	configuration := d.Get("configuration").(string)
@@ -173,7 +235,8 @@ func getTXTRecord(d *schema.ResourceData, m interface{}) error {
 	d.Set("bam_id", txtRecord.BAMId)
 	d.Set("properties", utils.JoinProperties(filteredProperties))
 	// for import functionality text must be set for the txt_record - required attribute
-	d.Set("text", parseRecordPropertyValue(txtRecord.Properties, "txt"))
+	remote := parseTXTData(utils.ParseProperties(txtRecord.Properties)["txt"])
+	d.Set("text", readTXTStrings(getTXTTexts(d), d.Get("auto_split").(bool), remote))
 
 	log.Debugf("Completed reading TXT record %s", d.Get("absolute_name"))
 	return nil
@@ -186,7 +249,6 @@ func updateTXTRecord(d *schema.ResourceData, m interface{}) error {
 	view := d.Get("view").(string)
 	zone := d.Get("zone").(string)
 	absoluteName := d.Get("absolute_name").(string)
-	text := d.Get("text").(string)
 	ttl := d.Get("ttl").(int)
 	properties := d.Get("properties").(string)
 
@@ -205,6 +267,13 @@ func updateTXTRecord(d *schema.ResourceData, m interface{}) error {
 	var immutableProperties = []string{"parentId", "parentType"} // these properties will raise error on the rest-api
 	properties = utils.RemoveImmutableProperties(properties, immutableProperties)
 
+	text, err := getTXTData(d)
+	if err != nil {
+		msg := fmt.Sprintf("Error updating TXT record %s: %s", fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+
 	txtRecord, err := objMgr.UpdateTXTRecord(configuration, view, zone, fqdnName, text, ttl, properties)
 	if err != nil {
 		msg := fmt.Sprintf("Error updating TXT record %s: %s", fqdnName, err)
@@ -214,13 +283,14 @@ func updateTXTRecord(d *schema.ResourceData, m interface{}) error {
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
 		txtRecord.BatchMode = d.Get("batch_mode").(string)
-		res, err := objMgr.Connector.DeployObject([]int{txtRecord.BAMId}, txtRecord.BatchMode)
+		status, err := objMgr.DeployObjects([]int{txtRecord.BAMId}, txtRecord.BatchMode)
+		d.Set("last_deployment_status", status)
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying TXT record %s: %s", absoluteName, err)
 			log.Debug(msg)
 			return fmt.Errorf(msg)
 		}
-		log.Debugf("Successfully deployed. %s", res)
+		log.Debugf("Successfully deployed. %s", status)
 	}
 	d.Set("absolute_name", fqdnName)
 	d.Set("bam_id", txtRecord.BAMId)
@@ -248,7 +318,7 @@ func deleteTXTRecord(d *schema.ResourceData, m interface{}) error {
 	}
 	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
 	if deploy {
-		res, err := objMgr.Connector.DeployObject([]int{bamID}, d.Get("batch_mode").(string))
+		res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
 		if err != nil {
 			msg := fmt.Sprintf("Error deploying TXT record %s: %s", absoluteName, err)
 			log.Debug(msg)
@@ -260,3 +330,40 @@ func deleteTXTRecord(d *schema.ResourceData, m interface{}) error {
 	log.Debugf("Completed to delete TXT record %s", d.Get("absolute_name"))
 	return nil
 }
+
+// getTXTTexts Get the configured texts of the TXT record
+func getTXTTexts(d *schema.ResourceData) []string {
+	texts := make([]string, 0)
+	for _, text := range d.Get("text").([]interface{}) {
+		value, _ := text.(string)
+		texts = append(texts, value)
+	}
+	return texts
+}
+
+// validateTXTStringsDiff rejects at plan time a text longer than a character-string without auto_split,
+// leaving the texts not yet known to the apply
+func validateTXTStringsDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("text") || !d.NewValueKnown("auto_split") {
+		return nil
+	}
+	texts := make([]string, 0)
+	for i, text := range d.Get("text").([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("text.%d", i)) {
+			continue
+		}
+		value, _ := text.(string)
+		texts = append(texts, value)
+	}
+	_, err := buildTXTStrings(texts, d.Get("auto_split").(bool))
+	return err
+}
+
+// getTXTData Get the text of the TXT record to send to BAM
+func getTXTData(d *schema.ResourceData) (string, error) {
+	strs, err := buildTXTStrings(getTXTTexts(d), d.Get("auto_split").(bool))
+	if err != nil {
+		return "", err
+	}
+	return formatTXTData(strs), nil
+}
@@ -0,0 +1,341 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceNAPTRRecord The NAPTR record
+func ResourceNAPTRRecord() *schema.Resource {
+	return resourceTypedRecord(naptrRData)
+}
+
+// ResourceCAARecord The CAA record
+func ResourceCAARecord() *schema.Resource {
+	return resourceTypedRecord(caaRData)
+}
+
+// ResourceSSHFPRecord The SSHFP record
+func ResourceSSHFPRecord() *schema.Resource {
+	return resourceTypedRecord(sshfpRData)
+}
+
+// ResourceTLSARecord The TLSA record
+func ResourceTLSARecord() *schema.Resource {
+	return resourceTypedRecord(tlsaRData)
+}
+
+// resourceTypedRecord A record kept by BAM as a generic record, with one attribute per RDATA component
+func resourceTypedRecord(rdata *rdataType) *schema.Resource {
+	recordSchema := map[string]*schema.Schema{
+		"configuration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The Configuration. Creating the %s record in the default Configuration if doesn't specify", rdata.Type),
+		},
+		"view": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The view which contains the details of the zone. If not provided, record will be created under default view",
+		},
+		"zone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The Zone in which you want to update a %s record. If not provided, the absolute name must be FQDN ones", rdata.Type),
+		},
+		"absolute_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: fmt.Sprintf("The name of the %s record. Must be FQDN if the Zone is not provided", rdata.Type),
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				zone := d.Get("zone").(string)
+				return checkDiffName(old, new, zone)
+			},
+		},
+		"data": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The data of the %s record as sent to BAM", rdata.Type),
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The TTL value",
+			Default:     -1,
+		},
+		"properties": {
+			Type:     schema.TypeString,
+			Optional: true,
+			StateFunc: func(v interface{}) string {
+				return utils.JoinProperties(utils.ParseProperties(v.(string)))
+			},
+			DiffSuppressFunc: suppressWhenRemoteHasSuperset,
+		},
+		"to_deploy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Whether or not to selectively deploy the %s record", rdata.Type),
+			Default:     "no",
+		},
+		"last_deployment_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The status of the last selective deployment of the %s record", rdata.Type),
+		},
+		"batch_mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Whether or not to use batch mode when selectively deploying",
+			Default:     "disabled",
+		},
+		"bam_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The entity id of the resource within BAM",
+		},
+	}
+	for _, f := range rdata.Fields {
+		recordSchema[f.Name] = f.Schema()
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return createTypedRecord(d, m, rdata)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return getTypedRecord(d, m, rdata)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return updateTypedRecord(d, m, rdata)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return deleteTypedRecord(d, m, rdata)
+		},
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setRecordZoneDefaultDiff("absolute_name")),
+		Schema:        recordSchema,
+		Importer: &schema.ResourceImporter{
+			State: recordImporter,
+		},
+	}
+}
+
+// createTypedRecord Create the new typed record as a generic record
+func createTypedRecord(d *schema.ResourceData, m interface{}, rdata *rdataType) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	applyRecordZoneDefault(d, m, "absolute_name")
+	log.Debugf("Beginning to create %s record %s", rdata.Type, d.Get("absolute_name"))
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := d.Get("zone").(string)
+	absoluteName := d.Get("absolute_name").(string)
+	ttl := d.Get("ttl").(int)
+	properties := d.Get("properties").(string)
+
+	data, err := rdata.Format(getRDataValues(d, rdata))
+	if err != nil {
+		return err
+	}
+
+	objMgr := GetObjManager(m)
+
+	fqdnName := absoluteName
+
+	if len(zone) > 0 {
+		fqdnName = getFQDN(absoluteName, zone)
+	} else {
+		zone = getZoneFromRRName(fqdnName)
+	}
+
+	record, err := objMgr.CreateGenericRecord(configuration, view, zone, rdata.Type, fqdnName, data, ttl, properties)
+	if err != nil {
+		msg := fmt.Sprintf("Error creating %s record %s: %s", rdata.Type, fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	d.SetId(fqdnName)
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", record.BAMId)
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy {
+		record.BatchMode = d.Get("batch_mode").(string)
+		status, err := objMgr.DeployObjects([]int{record.BAMId}, record.BatchMode)
+		d.Set("last_deployment_status", status)
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying %s record %s: %s", rdata.Type, absoluteName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+	log.Debugf("Completed to create %s record %s", rdata.Type, d.Get("absolute_name"))
+	return getTypedRecord(d, m, rdata)
+}
+
+// getTypedRecord Get the typed record and split its data into the RDATA components
+func getTypedRecord(d *schema.ResourceData, m interface{}, rdata *rdataType) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	log.Debugf("Beginning to get %s record: %s", rdata.Type, d.Get("absolute_name"))
+	absoluteName, err := getAbsoluteName(d)
+	if err != nil {
+		return err
+	}
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+
+	objMgr := GetObjManager(m)
+
+	record, err := objMgr.GetGenericRecord(configuration, view, absoluteName)
+	if err != nil {
+		if utils.IsNotFoundErr(err) {
+			if d.Id() != "" {
+				// If the record is missing remotely, remove from state so Terraform plans a create.
+				log.Warnf("%s Record %q not found; removing from state to trigger recreation", rdata.Type, d.Id())
+				d.SetId("")
+				return nil
+			}
+			// If we don't have an ID yet (e.g., during import resolution) surface the not-found
+			return fmt.Errorf("%s Record %s not found: %w", rdata.Type, absoluteName, err)
+		}
+		// Any other error is a real failure
+		return fmt.Errorf("Getting %s Record %s failed: %w", rdata.Type, absoluteName, err)
+	}
+	if record.TypeRR != "" && !strings.EqualFold(record.TypeRR, rdata.Type) {
+		return fmt.Errorf("Record %s is a %s record, not a %s record", absoluteName, record.TypeRR, rdata.Type)
+	}
+	values, err := rdata.Parse(genericRecordData(record))
+	if err != nil {
+		msg := fmt.Sprintf("Getting %s record %s failed: %s", rdata.Type, absoluteName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+
+	// --- Parse both server and config properties ---
+	bamProps := utils.ParseProperties(record.Properties)
+	cfgProps := utils.ParseProperties(d.Get("properties").(string))
+
+	// --- Filter server properties using keys from config ---
+	filteredProperties := utils.FilterProperties(bamProps, cfgProps)
+
+	d.SetId(record.AbsoluteName)
+	d.Set("absolute_name", record.AbsoluteName)
+	d.Set("bam_id", record.BAMId)
+	d.Set("properties", utils.JoinProperties(filteredProperties))
+	for name, value := range values {
+		d.Set(name, value)
+	}
+	// Keep the data in the form the resource writes it, whatever the form BAM returns
+	if formatted, err := rdata.Format(values); err == nil {
+		d.Set("data", formatted)
+	}
+	log.Debugf("Completed reading %s record %s", rdata.Type, d.Get("absolute_name"))
+	return nil
+}
+
+// updateTypedRecord Update the existing typed record
+func updateTypedRecord(d *schema.ResourceData, m interface{}, rdata *rdataType) error {
+	log.Debugf("Beginning to update %s record %s", rdata.Type, d.Get("absolute_name"))
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := d.Get("zone").(string)
+	absoluteName := d.Get("absolute_name").(string)
+	ttl := d.Get("ttl").(int)
+	properties := d.Get("properties").(string)
+
+	data, err := rdata.Format(getRDataValues(d, rdata))
+	if err != nil {
+		return err
+	}
+
+	objMgr := GetObjManager(m)
+
+	fqdnName := absoluteName
+
+	if len(zone) > 0 {
+		fqdnName = getFQDN(absoluteName, zone)
+	} else {
+		zone = getZoneFromRRName(fqdnName)
+	}
+
+	var immutableProperties = []string{"parentId", "parentType"} // these properties will raise error on the rest-api
+	properties = utils.RemoveImmutableProperties(properties, immutableProperties)
+
+	record, err := objMgr.UpdateGenericRecord(configuration, view, zone, rdata.Type, fqdnName, data, ttl, properties)
+	if err != nil {
+		msg := fmt.Sprintf("Error updating %s record %s: %s", rdata.Type, fqdnName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy {
+		record.BatchMode = d.Get("batch_mode").(string)
+		status, err := objMgr.DeployObjects([]int{record.BAMId}, record.BatchMode)
+		d.Set("last_deployment_status", status)
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying %s record %s: %s", rdata.Type, absoluteName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+	d.Set("absolute_name", fqdnName)
+	d.Set("bam_id", record.BAMId)
+	log.Debugf("Completed to update %s record %s", rdata.Type, d.Get("absolute_name"))
+	return getTypedRecord(d, m, rdata)
+}
+
+// deleteTypedRecord Delete the typed record
+func deleteTypedRecord(d *schema.ResourceData, m interface{}, rdata *rdataType) error {
+	log.Debugf("Beginning to delete %s record %s", rdata.Type, d.Get("absolute_name"))
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	absoluteName := d.Get("absolute_name").(string)
+	bamID := d.Get("bam_id").(int)
+
+	objMgr := GetObjManager(m)
+
+	_, err := objMgr.DeleteGenericRecord(configuration, view, absoluteName)
+	if err != nil {
+		msg := fmt.Sprintf("Deleting %s record %s failed: %s", rdata.Type, absoluteName, err)
+		log.Debug(msg)
+		return fmt.Errorf(msg)
+	}
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy {
+		res, err := objMgr.DeployAndWait([]int{bamID}, d.Get("batch_mode").(string))
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying %s record %s: %s", rdata.Type, absoluteName, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", res)
+	}
+	d.SetId("")
+	log.Debugf("Completed to delete %s record %s", rdata.Type, d.Get("absolute_name"))
+	return nil
+}
+
+// getRDataValues Get the RDATA components from the resource data
+func getRDataValues(d *schema.ResourceData, rdata *rdataType) map[string]interface{} {
+	values := make(map[string]interface{}, len(rdata.Fields))
+	for _, f := range rdata.Fields {
+		values[f.Name] = d.Get(f.Name)
+	}
+	return values
+}
+
+// genericRecordData Get the data of the generic record, from the rdata property if the Gateway doesn't return it
+func genericRecordData(record *entities.GenericRecord) string {
+	if record.Data != "" {
+		return record.Data
+	}
+	return utils.ParseProperties(record.Properties)["rdata"]
+}
@@ -15,15 +15,17 @@ import (
 func ResourceView() *schema.Resource {
 
 	return &schema.Resource{
-		Create: createView,
-		Read:   getView,
-		Update: updateView,
-		Delete: deleteView,
+		Create:        createView,
+		Read:          getView,
+		Update:        updateView,
+		Delete:        deleteView,
+		CustomizeDiff: setProviderDefaultsDiff("configuration"),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the View in the default Configuration if doesn't specify",
 			},
 			"name": {
@@ -54,6 +56,7 @@ func ResourceView() *schema.Resource {
 
 // createView creates a new View
 func createView(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 	log.Debugf("Beginning to create View %s", d.Get("address"))
 	configuration := d.Get("configuration").(string)
 	name := d.Get("name").(string)
@@ -85,6 +88,7 @@ func createView(d *schema.ResourceData, m interface{}) error {
 
 // getView Get the View
 func getView(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration")
 	log.Debugf("Beginning to get Block %s", d.Get("address"))
 	var viewName string
 	var err error
@@ -15,20 +15,23 @@ import (
 func ResourceZone() *schema.Resource {
 
 	return &schema.Resource{
-		Create: createZone,
-		Read:   getZone,
-		Update: updateZone,
-		Delete: deleteZone,
+		Create:        createZone,
+		Read:          getZone,
+		Update:        updateZone,
+		Delete:        deleteZone,
+		CustomizeDiff: setProviderDefaultsDiff("configuration", "view"),
 
 		Schema: map[string]*schema.Schema{
 			"configuration": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The Configuration. Creating the Zone in the default Configuration if doesn't specify",
 			},
 			"view": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The view which contains the details of the zone. If not provided, zone will be created under default view",
 			},
 			"zone": {
@@ -44,7 +47,8 @@ func ResourceZone() *schema.Resource {
 			"server_roles": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "The list of server roles. The format of each server role will be 'role type, server fqdn'",
+				Computed:    true,
+				Description: "The list of server roles. The format of each server role will be 'role type, server fqdn'. All the roles of the zone are read, so it can't be used with bluecat_dns_deployment_role on the same zone. Left to the roles in BAM if not provided",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"deployment_options": {
@@ -53,6 +57,12 @@ func ResourceZone() *schema.Resource {
 				Description: "The deployment options for the zone.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"ignore_unmanaged_options": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not to ignore the deployment options on all the servers which are not in deployment_options, instead of showing them as drift",
+			},
 			"properties": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -71,6 +81,7 @@ func ResourceZone() *schema.Resource {
 // createZone Create the new Zone
 // Create the Host record, then server will create the PTR
 func createZone(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to create Zone %s", d.Get("name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -160,6 +171,7 @@ func createZone(d *schema.ResourceData, m interface{}) error {
 
 // getZone Get the Zone
 func getZone(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
 	log.Debugf("Beginning to get Zone: %s", d.Get("name"))
 	configuration := d.Get("configuration").(string)
 	view := d.Get("view").(string)
@@ -224,8 +236,11 @@ func getZone(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("server_roles", serverRolesRaw)
 
-	deploymentOptionNames := utils.GetSortedMapKeys(utils.ExpandStringMap(d.Get("deployment_options")))
-	deploymentOptionsRaw, optionsErr := getDeploymentOptions(objMgr, configuration, view, zone, deploymentOptionNames)
+	deploymentOptionsRaw, optionsErr := utils.ListDeploymentOptions(objMgr, entities.DeploymentOption{
+		Configuration: configuration,
+		View:          view,
+		Zone:          zone,
+	}, utils.ExpandStringMap(d.Get("deployment_options")), d.Get("ignore_unmanaged_options").(bool))
 	if optionsErr != nil {
 		msg := fmt.Sprintf("error get deployment options on the zone: %s", optionsErr)
 		log.Debug(msg)
@@ -268,7 +283,11 @@ func updateZone(d *schema.ResourceData, m interface{}) error {
 	currentDeploymentOptionsRaw, newDeploymentOptionsRaw := d.GetChange("deployment_options")
 	newDeploymentOptions, currentDeploymentOptions := prepareDeploymentOptionData(currentDeploymentOptionsRaw, newDeploymentOptionsRaw)
 
-	trace, err := updateServerRoles(objMgr, currentServerRoles, newServerRoles, configuration, view, zone)
+	// the roles are left to BAM when server_roles is not configured, the read keeps it in sync otherwise
+	trace := make([][]string, 0)
+	if d.HasChange("server_roles") {
+		trace, err = updateServerRoles(objMgr, currentServerRoles, newServerRoles, configuration, view, zone)
+	}
 	if err == nil {
 		trace, err = updateDeploymentOptions(objMgr, currentDeploymentOptions, newDeploymentOptions, configuration, view, zone, trace)
 	}
@@ -607,26 +626,3 @@ func rollBackData(objMgr *utils.ObjectManager, trace [][]string, configuration s
 	}
 	return
 }
-
-func getDeploymentOptions(objMgr *utils.ObjectManager, configuration string, view string, zone string, optionNames []string) (map[string]string, error) {
-	deploymentOptions := make(map[string]string, len(optionNames))
-	if len(optionNames) == 0 {
-		return deploymentOptions, nil
-	}
-
-	for _, optionName := range optionNames {
-		deploymentOption, err := objMgr.GetDeploymentOption(entities.DeploymentOption{
-			Configuration: configuration,
-			View:          view,
-			Zone:          zone,
-			Name:          optionName,
-			ServerID:      utils.DeploymentOptionLookupServerID,
-		})
-		if err != nil {
-			return nil, err
-		}
-		deploymentOptions[optionName] = deploymentOption.Value
-	}
-
-	return deploymentOptions, nil
-}
@@ -0,0 +1,348 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceZoneRecords The records of a zone, declared as a whole
+func ResourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create:        createZoneRecords,
+		Read:          getZoneRecords,
+		Update:        updateZoneRecords,
+		Delete:        deleteZoneRecords,
+		CustomizeDiff: customizeDiffAll(setProviderDefaultsDiff("configuration", "view"), setIgnoreTypesDefaultDiff),
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Configuration. Using the default Configuration if doesn't specify",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The view which contains the zone. If not provided, the default view is used",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The FQDN of the zone",
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(strings.TrimSuffix(v.(string), "."))
+				},
+			},
+			"record": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The records of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the record relative to the zone, @ for the zone apex",
+							ValidateFunc: func(v interface{}, k string) (warnings []string, errs []error) {
+								if strings.HasSuffix(v.(string), ".") {
+									errs = append(errs, fmt.Errorf("%s must be relative to the zone, got %q", k, v.(string)))
+								}
+								return
+							},
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of the record, such as A, CNAME or MX",
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The data of the record, as for a generic record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     -1,
+							Description: "The TTL value",
+						},
+					},
+				},
+			},
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not to delete the records of the zone which are not declared, other than the ones of the ignored types",
+			},
+			"ignore_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The record types never read nor changed. GLUE stands for the address records of the delegated names. Default is SOA and NS, which BAM manages with the zone",
+			},
+			"to_deploy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to selectively deploy the records created, updated and deleted",
+				Default:     "no",
+			},
+			"last_deployment_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last selective deployment of the records",
+			},
+			"batch_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Whether or not to use batch mode when selectively deploying",
+				Default:     "disabled",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: zoneRecordsImporter,
+		},
+	}
+}
+
+// zoneRecordsImporter Import all the records of the zone, the zone being managed exclusively
+func zoneRecordsImporter(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	zone := strings.ToLower(strings.TrimSuffix(d.Id(), "."))
+	if zone == "" {
+		return nil, fmt.Errorf("unexpected format of zone records ID (%s), expected the zone FQDN", d.Id())
+	}
+	d.Set("zone", zone)
+	d.Set("exclusive", true)
+	d.Set("ignore_types", defaultIgnoreTypes)
+	d.SetId(zone)
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandZoneRecords Get the records of the record blocks
+func expandZoneRecords(v interface{}, zone string) []zoneRecord {
+	records := make([]zoneRecord, 0)
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return records
+	}
+	for _, item := range set.List() {
+		records = append(records, expandZoneRecord(item.(map[string]interface{}), zone))
+	}
+	return records
+}
+
+// expandZoneRecord Get the record of the record block
+func expandZoneRecord(value map[string]interface{}, zone string) zoneRecord {
+	return zoneRecord{
+		Name: relativeRecordName(value["name"].(string), zone),
+		Type: strings.ToUpper(value["type"].(string)),
+		Data: value["data"].(string),
+		TTL:  value["ttl"].(int),
+	}
+}
+
+// defaultIgnoreTypes The record types ignored when ignore_types isn't configured, the apex records BAM manages with the zone
+var defaultIgnoreTypes = []string{"SOA", "NS"}
+
+// setIgnoreTypesDefaultDiff Plan the default ignore_types when it isn't configured, an empty set ignoring nothing
+func setIgnoreTypesDefaultDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	if ignoreTypes := config.GetAttr("ignore_types"); ignoreTypes.IsNull() {
+		return d.SetNew("ignore_types", defaultIgnoreTypes)
+	}
+	return nil
+}
+
+// getIgnoreTypes Get the ignored record types
+func getIgnoreTypes(d *schema.ResourceData) []string {
+	types := make([]string, 0)
+	for _, item := range d.Get("ignore_types").(*schema.Set).List() {
+		types = append(types, item.(string))
+	}
+	return types
+}
+
+// getExistingZoneRecords Get the records of the zone in BAM, other than the ones of the ignored types
+func getExistingZoneRecords(d *schema.ResourceData, objMgr *utils.ObjectManager) ([]zoneRecord, error) {
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := d.Get("zone").(string)
+	supported, err := utils.ResourceRecordsSupported(objMgr, configuration, view, zone)
+	if err != nil {
+		log.Debugf("Getting the records of the zone %s failed: %s", zone, err)
+		return nil, fmt.Errorf("Getting the records of the zone %s failed: %w", zone, err)
+	}
+	if !supported {
+		msg := fmt.Sprintf("Getting the records of the zone %s failed: the Gateway has no resource_records endpoints", zone)
+		log.Debug(msg)
+		return nil, fmt.Errorf(msg)
+	}
+	records, err := objMgr.GetZoneResourceRecords(configuration, view, zone)
+	if err != nil {
+		log.Debugf("Getting the records of the zone %s failed: %s", zone, err)
+		return nil, fmt.Errorf("Getting the records of the zone %s failed: %w", zone, err)
+	}
+	return filterIgnoredZoneRecords(zoneRecordsFromBAM(records.Records, zone), getIgnoreTypes(d)), nil
+}
+
+// createZoneRecords Create the records of the zone
+func createZoneRecords(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	zone := strings.ToLower(strings.TrimSuffix(d.Get("zone").(string), "."))
+	log.Debugf("Beginning to create the records of the zone %s", zone)
+	if err := applyZoneRecords(d, m, expandZoneRecords(d.Get("record"), zone), []zoneRecord{}); err != nil {
+		return err
+	}
+	d.SetId(zone)
+	log.Debugf("Completed to create the records of the zone %s", zone)
+	return getZoneRecords(d, m)
+}
+
+// getZoneRecords Get the records of the zone
+func getZoneRecords(d *schema.ResourceData, m interface{}) error {
+	applyProviderDefaults(d, m, "configuration", "view")
+	zone := d.Get("zone").(string)
+	log.Debugf("Beginning to get the records of the zone %s", zone)
+
+	objMgr := GetObjManager(m)
+
+	existing, err := getExistingZoneRecords(d, objMgr)
+	if err != nil {
+		if utils.IsNotFoundErr(err) && d.Id() != "" {
+			log.Warnf("Zone %q not found; removing its records from state to trigger recreation", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// keep the configured form of the names, types and data matching the records of BAM
+	configured := make(map[string]map[string]interface{})
+	for _, item := range d.Get("record").(*schema.Set).List() {
+		value := item.(map[string]interface{})
+		configured[expandZoneRecord(value, zone).identity()] = value
+	}
+	managed := expandZoneRecords(d.Get("record"), zone)
+	records := readZoneRecords(existing, managed, managed, d.Get("exclusive").(bool))
+	flattened := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		value := map[string]interface{}{
+			"name": record.Name,
+			"type": record.Type,
+			"data": record.Data,
+		}
+		if known, ok := configured[record.identity()]; ok {
+			value["name"] = known["name"]
+			value["type"] = known["type"]
+			value["data"] = known["data"]
+		}
+		value["ttl"] = record.TTL
+		flattened = append(flattened, value)
+	}
+	d.Set("record", flattened)
+	log.Debugf("Completed reading the records of the zone %s: %d records", zone, len(records))
+	return nil
+}
+
+// updateZoneRecords Create, update and delete the records of the zone
+func updateZoneRecords(d *schema.ResourceData, m interface{}) error {
+	zone := d.Get("zone").(string)
+	log.Debugf("Beginning to update the records of the zone %s", zone)
+	old, new := d.GetChange("record")
+	if err := applyZoneRecords(d, m, expandZoneRecords(new, zone), expandZoneRecords(old, zone)); err != nil {
+		return err
+	}
+	log.Debugf("Completed to update the records of the zone %s", zone)
+	return getZoneRecords(d, m)
+}
+
+// deleteZoneRecords Delete the records of the zone managed by the resource
+func deleteZoneRecords(d *schema.ResourceData, m interface{}) error {
+	zone := d.Get("zone").(string)
+	log.Debugf("Beginning to delete the records of the zone %s", zone)
+	d.Set("exclusive", false)
+	if err := applyZoneRecords(d, m, []zoneRecord{}, expandZoneRecords(d.Get("record"), zone)); err != nil {
+		return err
+	}
+	d.SetId("")
+	log.Debugf("Completed to delete the records of the zone %s", zone)
+	return nil
+}
+
+// applyZoneRecords Make the zone hold the desired records, the managed ones being the records previously declared
+func applyZoneRecords(d *schema.ResourceData, m interface{}, desired []zoneRecord, managed []zoneRecord) error {
+	configuration := d.Get("configuration").(string)
+	view := d.Get("view").(string)
+	zone := strings.ToLower(strings.TrimSuffix(d.Get("zone").(string), "."))
+
+	objMgr := GetObjManager(m)
+
+	existing, err := getExistingZoneRecords(d, objMgr)
+	if err != nil {
+		return err
+	}
+	create, update, remove := planZoneRecords(desired, existing, managed, d.Get("exclusive").(bool))
+	log.Debugf("Records of the zone %s: creating %d, updating %d, deleting %d", zone, len(create), len(update), len(remove))
+
+	ids := make([]int, 0, len(create)+len(update)+len(remove))
+	for _, record := range remove {
+		if _, err := objMgr.DeleteResourceRecord(configuration, view, record.ID); err != nil && !utils.IsNotFoundErr(err) {
+			msg := fmt.Sprintf("Error deleting %s record %s of the zone %s: %s", record.Type, record.Name, zone, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		ids = append(ids, record.ID)
+	}
+	for _, record := range update {
+		if _, err := objMgr.UpdateResourceRecord(configuration, view, record.ID, record.Data, record.TTL); err != nil {
+			msg := fmt.Sprintf("Error updating %s record %s of the zone %s: %s", record.Type, record.Name, zone, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		ids = append(ids, record.ID)
+	}
+	for _, record := range create {
+		created, err := objMgr.CreateGenericRecord(configuration, view, zone, record.Type, absoluteRecordName(record.Name, zone), record.Data, record.TTL, "")
+		if err != nil {
+			msg := fmt.Sprintf("Error creating %s record %s of the zone %s: %s", record.Type, record.Name, zone, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		ids = append(ids, created.BAMId)
+	}
+
+	deploy := utils.ParseDeploymentValue(d.Get("to_deploy").(string))
+	if deploy && len(ids) > 0 {
+		batchMode := d.Get("batch_mode").(string)
+		var status string
+		if len(remove) > 0 {
+			// the deleted records can't wait in the deployment queue
+			status, err = objMgr.DeployAndWait(ids, batchMode)
+		} else {
+			status, err = objMgr.DeployObjects(ids, batchMode)
+		}
+		d.Set("last_deployment_status", status)
+		if err != nil {
+			msg := fmt.Sprintf("Error deploying the records of the zone %s: %s", zone, err)
+			log.Debug(msg)
+			return fmt.Errorf(msg)
+		}
+		log.Debugf("Successfully deployed. %s", status)
+	}
+	return nil
+}
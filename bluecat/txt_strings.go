@@ -0,0 +1,86 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// txtMaxStringLength The maximum length in bytes of a DNS character-string
+const txtMaxStringLength = 255
+
+// splitTXTString Split the text into character-strings of at most 255 bytes, without breaking a UTF-8 character
+func splitTXTString(text string) []string {
+	chunks := make([]string, 0, len(text)/txtMaxStringLength+1)
+	for len(text) > txtMaxStringLength {
+		cut := txtMaxStringLength
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		chunks = append(chunks, text[:cut])
+		text = text[cut:]
+	}
+	return append(chunks, text)
+}
+
+// buildTXTStrings Get the character-strings of the TXT record, splitting the texts longer than 255 bytes if allowed
+func buildTXTStrings(texts []string, autoSplit bool) ([]string, error) {
+	strs := make([]string, 0, len(texts))
+	for _, text := range texts {
+		if len(text) <= txtMaxStringLength {
+			strs = append(strs, text)
+			continue
+		}
+		if !autoSplit {
+			return nil, fmt.Errorf("the text %.20q... is %d bytes long, the limit is %d bytes. Split it or set auto_split", text, len(text), txtMaxStringLength)
+		}
+		strs = append(strs, splitTXTString(text)...)
+	}
+	return strs, nil
+}
+
+// formatTXTData Write the character-strings as the text sent to BAM. A single plain string is sent as is,
+// otherwise each string is quoted and escaped
+func formatTXTData(strs []string) string {
+	if len(strs) == 1 && !strings.ContainsAny(strs[0], `"\`) {
+		return strs[0]
+	}
+	quoted := make([]string, 0, len(strs))
+	for _, str := range strs {
+		quoted = append(quoted, quoteRDataString(str))
+	}
+	return strings.Join(quoted, " ")
+}
+
+// parseTXTData Read the character-strings from the text returned by BAM, quoted or not
+func parseTXTData(data string) []string {
+	if !strings.HasPrefix(strings.TrimSpace(data), `"`) {
+		return []string{data}
+	}
+	strs, err := splitRData(data)
+	if err != nil || len(strs) == 0 {
+		return []string{data}
+	}
+	return strs
+}
+
+// readTXTStrings Get the texts to keep in the state: the configured ones when they match the character-strings
+// returned by BAM once split, so a text split on write doesn't show as drift, or else the strings returned by BAM
+func readTXTStrings(configured []string, autoSplit bool, remote []string) []string {
+	expected, err := buildTXTStrings(configured, autoSplit)
+	if err == nil && len(expected) == len(remote) {
+		same := true
+		for i := range expected {
+			if expected[i] != remote[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return configured
+		}
+	}
+	return remote
+}
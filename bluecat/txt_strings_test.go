@@ -0,0 +1,106 @@
+package bluecat
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSplitTXTStringKeepsUTF8Characters(t *testing.T) {
+	text := strings.Repeat("a", 254) + "é" + strings.Repeat("b", 300)
+	chunks := splitTXTString(text)
+	if strings.Join(chunks, "") != text {
+		t.Fatal("expected the chunks to join back into the text")
+	}
+	for _, chunk := range chunks {
+		if len(chunk) > txtMaxStringLength {
+			t.Fatalf("expected chunks of at most %d bytes, got %d", txtMaxStringLength, len(chunk))
+		}
+		if !utf8.ValidString(chunk) {
+			t.Fatalf("expected valid UTF-8 chunks, got %q", chunk)
+		}
+	}
+	if len(chunks[0]) != 254 {
+		t.Fatalf("expected the first chunk to stop before the 2-byte character, got %d bytes", len(chunks[0]))
+	}
+}
+
+func TestBuildTXTStringsRejectsLongTextWithoutAutoSplit(t *testing.T) {
+	if _, err := buildTXTStrings([]string{strings.Repeat("x", 256)}, false); err == nil {
+		t.Fatal("expected an error for a text longer than 255 bytes")
+	}
+	strs, err := buildTXTStrings([]string{strings.Repeat("x", 256), "short"}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(strs) != 3 || len(strs[0]) != 255 || strs[1] != "x" || strs[2] != "short" {
+		t.Fatalf("unexpected split %q", strs)
+	}
+}
+
+func TestTXTDataRoundTrip(t *testing.T) {
+	cases := []struct {
+		strs []string
+		data string
+	}{
+		{[]string{"v=spf1 -all"}, "v=spf1 -all"},
+		{[]string{"first", "second part"}, `"first" "second part"`},
+		{[]string{`say "hello"`}, `"say \"hello\""`},
+		{[]string{`back\slash`, ""}, `"back\\slash" ""`},
+	}
+	for _, c := range cases {
+		data := formatTXTData(c.strs)
+		if data != c.data {
+			t.Errorf("expected %s, got %s", c.data, data)
+		}
+		if got := parseTXTData(data); !reflect.DeepEqual(got, c.strs) {
+			t.Errorf("expected %q after the round trip, got %q", c.strs, got)
+		}
+	}
+}
+
+func TestReadTXTStringsKeepsConfiguredText(t *testing.T) {
+	long := strings.Repeat("k", 300)
+	remote := parseTXTData(formatTXTData(splitTXTString(long)))
+	if got := readTXTStrings([]string{long}, true, remote); !reflect.DeepEqual(got, []string{long}) {
+		t.Fatalf("expected the configured text, got %q", got)
+	}
+	// a change made outside Terraform shows up as drift
+	if got := readTXTStrings([]string{long}, true, []string{"changed"}); !reflect.DeepEqual(got, []string{"changed"}) {
+		t.Fatalf("expected the remote text, got %q", got)
+	}
+}
+
+func TestUpgradeTXTRecordStateV0(t *testing.T) {
+	state, err := upgradeTXTRecordStateV0(context.Background(), map[string]interface{}{"text": `"a" "b"`}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(state["text"], []interface{}{"a", "b"}) {
+		t.Fatalf("expected the text split in its strings, got %v", state["text"])
+	}
+	state, _ = upgradeTXTRecordStateV0(context.Background(), map[string]interface{}{"text": "plain text"}, nil)
+	if !reflect.DeepEqual(state["text"], []interface{}{"plain text"}) {
+		t.Fatalf("expected the text as a single string, got %v", state["text"])
+	}
+}
+
+func TestTXTRecordPlanRejectsALongTextWithoutAutoSplit(t *testing.T) {
+	config := map[string]interface{}{
+		"absolute_name": "txt.example.com",
+		"text":          []interface{}{strings.Repeat("a", 300)},
+	}
+	_, err := ResourceTXTRecord().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	if err == nil || !strings.Contains(err.Error(), "auto_split") {
+		t.Fatalf("expected the plan to reject the text, got %v", err)
+	}
+
+	config["auto_split"] = true
+	if _, err := ResourceTXTRecord().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil); err != nil {
+		t.Fatalf("expected the plan to split the text, got %v", err)
+	}
+}
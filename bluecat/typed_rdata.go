@@ -0,0 +1,271 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rdataFieldKind How an RDATA component is written in the record data
+type rdataFieldKind int
+
+const (
+	// rdataInt An unsigned integer
+	rdataInt rdataFieldKind = iota
+	// rdataToken A single unquoted word, such as a tag or a domain name
+	rdataToken
+	// rdataString A character-string, always quoted when written
+	rdataString
+	// rdataHex Hexadecimal data, may be split by spaces. Only allowed as the last component
+	rdataHex
+)
+
+// rdataField One component of the RDATA of a typed record
+type rdataField struct {
+	Name        string
+	Kind        rdataFieldKind
+	Max         int
+	Default     interface{}
+	Description string
+	Normalize   func(string) string
+}
+
+// rdataType The RDATA layout of a record type kept by BAM as a generic record
+type rdataType struct {
+	Type   string
+	Fields []rdataField
+}
+
+var naptrRData = &rdataType{
+	Type: "NAPTR",
+	Fields: []rdataField{
+		{Name: "order", Kind: rdataInt, Max: 65535, Description: "The order in which the NAPTR records must be processed, lower first"},
+		{Name: "preference", Kind: rdataInt, Max: 65535, Description: "The order in which the NAPTR records with the same order should be processed, lower first"},
+		{Name: "flags", Kind: rdataString, Default: "", Normalize: strings.ToUpper, Description: "The flags controlling the rewriting, such as U, S, A or P"},
+		{Name: "service", Kind: rdataString, Default: "", Description: "The service parameters, such as E2U+sip"},
+		{Name: "regexp", Kind: rdataString, Default: "", Description: "The substitution expression applied to the original string"},
+		{Name: "replacement", Kind: rdataToken, Default: ".", Normalize: normalizeRDataDomain, Description: "The next domain name to query, . if the regexp is used"},
+	},
+}
+
+var caaRData = &rdataType{
+	Type: "CAA",
+	Fields: []rdataField{
+		{Name: "flags", Kind: rdataInt, Max: 255, Default: 0, Description: "The CAA flags, 128 for the issuer critical flag"},
+		{Name: "tag", Kind: rdataToken, Normalize: strings.ToLower, Description: "The property tag: issue, issuewild or iodef"},
+		{Name: "value", Kind: rdataString, Description: "The value of the property, such as the domain of the certificate authority"},
+	},
+}
+
+var sshfpRData = &rdataType{
+	Type: "SSHFP",
+	Fields: []rdataField{
+		{Name: "algorithm", Kind: rdataInt, Max: 255, Description: "The algorithm of the SSH key: 1 RSA, 2 DSA, 3 ECDSA, 4 Ed25519"},
+		{Name: "fingerprint_type", Kind: rdataInt, Max: 255, Description: "The hash of the fingerprint: 1 SHA-1, 2 SHA-256"},
+		{Name: "fingerprint", Kind: rdataHex, Normalize: normalizeRDataHex, Description: "The fingerprint of the SSH key in hexadecimal"},
+	},
+}
+
+var tlsaRData = &rdataType{
+	Type: "TLSA",
+	Fields: []rdataField{
+		{Name: "usage", Kind: rdataInt, Max: 255, Description: "The certificate usage: 0 PKIX-TA, 1 PKIX-EE, 2 DANE-TA, 3 DANE-EE"},
+		{Name: "selector", Kind: rdataInt, Max: 255, Description: "The part of the certificate matched: 0 full certificate, 1 public key"},
+		{Name: "matching_type", Kind: rdataInt, Max: 255, Description: "How the data is matched: 0 exact, 1 SHA-256, 2 SHA-512"},
+		{Name: "certificate_association_data", Kind: rdataHex, Normalize: normalizeRDataHex, Description: "The certificate association data in hexadecimal"},
+	},
+}
+
+// typedRDataTypes The RDATA layouts by record type
+var typedRDataTypes = map[string]*rdataType{
+	naptrRData.Type: naptrRData,
+	caaRData.Type:   caaRData,
+	sshfpRData.Type: sshfpRData,
+	tlsaRData.Type:  tlsaRData,
+}
+
+// Schema The schema of the RDATA component in the typed record resource
+func (f rdataField) Schema() *schema.Schema {
+	s := &schema.Schema{
+		Description: f.Description,
+	}
+	if f.Default != nil {
+		s.Optional = true
+		s.Default = f.Default
+	} else {
+		s.Required = true
+	}
+	if f.Kind == rdataInt {
+		s.Type = schema.TypeInt
+		s.ValidateFunc = func(v interface{}, k string) (warnings []string, errs []error) {
+			value := v.(int)
+			if value < 0 || value > f.Max {
+				errs = append(errs, fmt.Errorf("%s must be between 0 and %d, got %d", k, f.Max, value))
+			}
+			return
+		}
+		return s
+	}
+	s.Type = schema.TypeString
+	s.StateFunc = func(v interface{}) string {
+		return f.normalize(v.(string))
+	}
+	s.ValidateFunc = func(v interface{}, k string) (warnings []string, errs []error) {
+		if _, err := f.parse(v.(string)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", k, err))
+		}
+		return
+	}
+	return s
+}
+
+func (f rdataField) normalize(value string) string {
+	if f.Normalize != nil {
+		return f.Normalize(value)
+	}
+	return value
+}
+
+// parse Check the value of a string component and return it normalized
+func (f rdataField) parse(value string) (string, error) {
+	value = f.normalize(value)
+	switch f.Kind {
+	case rdataToken:
+		if value == "" || strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+			return "", fmt.Errorf("expected a single word, got %q", value)
+		}
+	case rdataHex:
+		if value == "" {
+			return "", fmt.Errorf("expected hexadecimal data, got an empty value")
+		}
+		if _, err := hex.DecodeString(value); err != nil {
+			return "", fmt.Errorf("expected hexadecimal data, got %q", value)
+		}
+	}
+	return value, nil
+}
+
+// Format Write the RDATA components as the data of the generic record
+func (t *rdataType) Format(values map[string]interface{}) (string, error) {
+	parts := make([]string, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		if f.Kind == rdataInt {
+			value, _ := values[f.Name].(int)
+			if value < 0 || value > f.Max {
+				return "", fmt.Errorf("%s %s must be between 0 and %d, got %d", t.Type, f.Name, f.Max, value)
+			}
+			parts = append(parts, strconv.Itoa(value))
+			continue
+		}
+		value, _ := values[f.Name].(string)
+		value, err := f.parse(value)
+		if err != nil {
+			return "", fmt.Errorf("%s %s: %s", t.Type, f.Name, err)
+		}
+		if f.Kind == rdataString {
+			value = quoteRDataString(value)
+		}
+		parts = append(parts, value)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// Parse Read the RDATA components from the data of the generic record, in the
+// form written by Format or in the presentation form returned by BAM
+func (t *rdataType) Parse(data string) (map[string]interface{}, error) {
+	tokens, err := splitRData(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s data %q failed: %s", t.Type, data, err)
+	}
+	values := make(map[string]interface{}, len(t.Fields))
+	for i, f := range t.Fields {
+		if i >= len(tokens) {
+			return nil, fmt.Errorf("parsing %s data %q failed: missing %s", t.Type, data, f.Name)
+		}
+		token := tokens[i]
+		if f.Kind == rdataHex {
+			token = strings.Join(tokens[i:], "")
+			tokens = tokens[:i+1]
+		}
+		if f.Kind == rdataInt {
+			value, err := strconv.Atoi(token)
+			if err != nil || value < 0 || value > f.Max {
+				return nil, fmt.Errorf("parsing %s data %q failed: %s must be between 0 and %d, got %q", t.Type, data, f.Name, f.Max, token)
+			}
+			values[f.Name] = value
+			continue
+		}
+		value, err := f.parse(token)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s data %q failed: %s %s", t.Type, data, f.Name, err)
+		}
+		values[f.Name] = value
+	}
+	if len(tokens) > len(t.Fields) {
+		return nil, fmt.Errorf("parsing %s data %q failed: expected %d components, got %d", t.Type, data, len(t.Fields), len(tokens))
+	}
+	return values, nil
+}
+
+// splitRData Split the record data on the spaces, keeping the quoted strings whole and unescaped
+func splitRData(data string) ([]string, error) {
+	tokens := make([]string, 0)
+	var current strings.Builder
+	inToken, quoted, escaped := false, false, false
+	for _, r := range data {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			inToken = true
+			escaped = true
+		case r == '"':
+			inToken = true
+			quoted = !quoted
+		case !quoted && unicode.IsSpace(r):
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		default:
+			inToken = true
+			current.WriteRune(r)
+		}
+	}
+	if quoted || escaped {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+// quoteRDataString Quote the character-string, escaping the quotes and backslashes
+func quoteRDataString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, value)
+}
+
+// normalizeRDataHex Drop the spaces and lower the case of hexadecimal data
+func normalizeRDataHex(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), ""))
+}
+
+// normalizeRDataDomain Drop the trailing dot of the domain name, the root domain stays as .
+func normalizeRDataDomain(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "." {
+		return "."
+	}
+	return strings.TrimSuffix(value, ".")
+}
@@ -0,0 +1,182 @@
+package bluecat
+
+import (
+	"encoding/json"
+	"reflect"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// roundTripGenericRecord Send the values through the GenericRecord entity as the REST API does and read them back
+func roundTripGenericRecord(t *testing.T, rdata *rdataType, values map[string]interface{}) (string, map[string]interface{}) {
+	data, err := rdata.Format(values)
+	if err != nil {
+		t.Fatalf("formatting %s failed: %s", rdata.Type, err)
+	}
+	body, err := json.Marshal(entities.GenericRecord{TypeRR: rdata.Type, AbsoluteName: "rr.example.com", Data: data})
+	if err != nil {
+		t.Fatalf("marshalling %s failed: %s", rdata.Type, err)
+	}
+	record := entities.GenericRecord{}
+	if err := json.Unmarshal(body, &record); err != nil {
+		t.Fatalf("unmarshalling %s failed: %s", rdata.Type, err)
+	}
+	if record.TypeRR != rdata.Type {
+		t.Fatalf("expected type %s, got %s", rdata.Type, record.TypeRR)
+	}
+	parsed, err := rdata.Parse(record.Data)
+	if err != nil {
+		t.Fatalf("parsing %s failed: %s", rdata.Type, err)
+	}
+	return data, parsed
+}
+
+func TestTypedRecordRoundTrip(t *testing.T) {
+	cases := []struct {
+		rdata  *rdataType
+		values map[string]interface{}
+		data   string
+	}{
+		{
+			rdata: naptrRData,
+			values: map[string]interface{}{
+				"order": 100, "preference": 10, "flags": "U", "service": "E2U+sip",
+				"regexp": `!^\+1(.*)$!sip:\1@example.com!`, "replacement": ".",
+			},
+			data: `100 10 "U" "E2U+sip" "!^\\+1(.*)$!sip:\\1@example.com!" .`,
+		},
+		{
+			rdata: naptrRData,
+			values: map[string]interface{}{
+				"order": 0, "preference": 65535, "flags": "S", "service": "SIP+D2U",
+				"regexp": "", "replacement": "_sip._udp.example.com",
+			},
+			data: `0 65535 "S" "SIP+D2U" "" _sip._udp.example.com`,
+		},
+		{
+			rdata:  caaRData,
+			values: map[string]interface{}{"flags": 0, "tag": "issue", "value": "letsencrypt.org"},
+			data:   `0 issue "letsencrypt.org"`,
+		},
+		{
+			rdata:  caaRData,
+			values: map[string]interface{}{"flags": 128, "tag": "iodef", "value": `mailto:"security"@example.com`},
+			data:   `128 iodef "mailto:\"security\"@example.com"`,
+		},
+		{
+			rdata:  sshfpRData,
+			values: map[string]interface{}{"algorithm": 4, "fingerprint_type": 2, "fingerprint": "a1b2c3d4e5f6"},
+			data:   `4 2 a1b2c3d4e5f6`,
+		},
+		{
+			rdata: tlsaRData,
+			values: map[string]interface{}{
+				"usage": 3, "selector": 1, "matching_type": 1,
+				"certificate_association_data": "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6",
+			},
+			data: `3 1 1 0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6`,
+		},
+	}
+	for _, c := range cases {
+		data, parsed := roundTripGenericRecord(t, c.rdata, c.values)
+		if data != c.data {
+			t.Errorf("%s: expected data %s, got %s", c.rdata.Type, c.data, data)
+		}
+		if !reflect.DeepEqual(parsed, c.values) {
+			t.Errorf("%s: expected %v after the round trip, got %v", c.rdata.Type, c.values, parsed)
+		}
+	}
+}
+
+func TestTypedRecordParseNormalizesBAMData(t *testing.T) {
+	// BAM may return the data in another presentation form, which must read back as the configured values
+	cases := []struct {
+		rdata    *rdataType
+		data     string
+		expected map[string]interface{}
+	}{
+		{
+			rdata: naptrRData,
+			data:  `100  10 u "E2U+sip" "!^.*$!sip:info@example.com!" example.com.`,
+			expected: map[string]interface{}{
+				"order": 100, "preference": 10, "flags": "U", "service": "E2U+sip",
+				"regexp": "!^.*$!sip:info@example.com!", "replacement": "example.com",
+			},
+		},
+		{
+			rdata:    caaRData,
+			data:     `0 ISSUE letsencrypt.org`,
+			expected: map[string]interface{}{"flags": 0, "tag": "issue", "value": "letsencrypt.org"},
+		},
+		{
+			rdata:    sshfpRData,
+			data:     `1 1 A1B2C3 D4E5F6`,
+			expected: map[string]interface{}{"algorithm": 1, "fingerprint_type": 1, "fingerprint": "a1b2c3d4e5f6"},
+		},
+		{
+			rdata:    tlsaRData,
+			data:     "2 0 2 ABCD\tEF01",
+			expected: map[string]interface{}{"usage": 2, "selector": 0, "matching_type": 2, "certificate_association_data": "abcdef01"},
+		},
+	}
+	for _, c := range cases {
+		parsed, err := c.rdata.Parse(c.data)
+		if err != nil {
+			t.Fatalf("%s: parsing %s failed: %s", c.rdata.Type, c.data, err)
+		}
+		if !reflect.DeepEqual(parsed, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.rdata.Type, c.expected, parsed)
+		}
+	}
+}
+
+func TestTypedRecordParseRejectsInvalidData(t *testing.T) {
+	cases := []struct {
+		rdata *rdataType
+		data  string
+	}{
+		{caaRData, `0 issue`},
+		{caaRData, `256 issue "ca.example.net"`},
+		{caaRData, `0 issue "ca.example.net`},
+		{caaRData, `0 issue "ca.example.net" extra`},
+		{sshfpRData, `1 2 not-hex`},
+		{tlsaRData, `3 1 1`},
+		{naptrRData, `-1 10 "U" "E2U+sip" "" .`},
+	}
+	for _, c := range cases {
+		if _, err := c.rdata.Parse(c.data); err == nil {
+			t.Errorf("%s: expected an error parsing %s", c.rdata.Type, c.data)
+		}
+	}
+}
+
+func TestTypedRecordStateIsNormalized(t *testing.T) {
+	// The configured value is stored normalized, so it matches what is read back from BAM
+	resource := ResourceSSHFPRecord()
+	fingerprint := resource.Schema["fingerprint"]
+	if got := fingerprint.StateFunc("A1B2 C3D4"); got != "a1b2c3d4" {
+		t.Fatalf("expected a1b2c3d4, got %s", got)
+	}
+	if _, errs := fingerprint.ValidateFunc("xyz", "fingerprint"); len(errs) == 0 {
+		t.Fatal("expected a validation error for non hexadecimal fingerprint")
+	}
+	if _, errs := resource.Schema["algorithm"].ValidateFunc(256, "algorithm"); len(errs) == 0 {
+		t.Fatal("expected a validation error for algorithm 256")
+	}
+
+	caa := ResourceCAARecord()
+	data := schema.TestResourceDataRaw(t, caa.Schema, map[string]interface{}{
+		"absolute_name": "example.com",
+		"tag":           "issuewild",
+		"value":         "ca.example.net",
+	})
+	formatted, err := caaRData.Format(getRDataValues(data, caaRData))
+	if err != nil {
+		t.Fatalf("formatting CAA failed: %s", err)
+	}
+	if formatted != `0 issuewild "ca.example.net"` {
+		t.Fatalf("expected the default flags, got %s", formatted)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"terraform-provider-bluecat/bluecat/entities"
 	"terraform-provider-bluecat/bluecat/logging"
 	"terraform-provider-bluecat/bluecat/models"
+	"time"
 
 	"io/ioutil"
 	"net/http"
@@ -38,6 +39,26 @@ type HostConfig struct {
 	EncryptPassword bool
 }
 
+// ProviderDefaults The provider-level defaults used by resources that don't set their own value
+type ProviderDefaults struct {
+	Configuration string
+	View          string
+	Zone          string
+}
+
+// Get Returns the default value for the given resource attribute
+func (pd ProviderDefaults) Get(attribute string) string {
+	switch attribute {
+	case "configuration":
+		return pd.Configuration
+	case "view":
+		return pd.View
+	case "zone":
+		return pd.Zone
+	}
+	return ""
+}
+
 // RequestType HTTP request types
 type RequestType int
 
@@ -81,10 +102,14 @@ type HTTPRequester interface {
 
 // Connector Connector object
 type Connector struct {
-	HostConfig     HostConfig
-	RequestBuilder HTTPRequestBuilder
-	Requester      HTTPRequester
-	RestToken      RestAPIToken
+	HostConfig        HostConfig
+	RequestBuilder    HTTPRequestBuilder
+	Requester         HTTPRequester
+	RestToken         RestAPIToken
+	Defaults          ProviderDefaults
+	DeferDeployment   bool
+	DeploymentQueue   *DeploymentQueue
+	DeploymentTimeout time.Duration
 }
 
 // RestAPIToken Rest API access token object
@@ -96,9 +121,11 @@ type RestAPIToken struct {
 type BCConnector interface {
 	CreateObject(obj entities.BAMObject) (ref string, err error)
 	GetObject(obj entities.BAMObject, res interface{}) error
+	ListObjects(obj entities.BAMObject, res interface{}) error
 	UpdateObject(obj entities.BAMObject, res interface{}) (err error)
 	DeleteObject(obj entities.BAMObject) (res string, err error)
 	DeployObject(ids []int, batchMode string) (res string, err error)
+	DeployServiceObject(ids []int, batchMode string, service string) (res string, err error)
 }
 
 // APIRequestBuilder Rest API request builder
@@ -111,7 +138,7 @@ type HTTPRequestBuilder interface {
 	Init(HostConfig)
 	BuildRequest(r RequestType, obj entities.BAMObject) (req *http.Request, err error)
 	BuildLoginRequest(r RequestType, obj entities.BAMObject) (req *http.Request, err error)
-	BuildDeployRequest(ids []int, batchMode string) (req *http.Request, err error)
+	BuildDeployRequest(ids []int, batchMode string, service string) (req *http.Request, err error)
 }
 
 // Init Initialize the Rest API requester
@@ -132,9 +159,10 @@ func (ahr *APIHttpRequester) Init() {
 // NewConnector Initialize the connector
 func NewConnector(hostConfig HostConfig, requestBuilder HTTPRequestBuilder, requester HTTPRequester) (connector *Connector, err error) {
 	connector = &Connector{
-		HostConfig:     hostConfig,
-		RequestBuilder: requestBuilder,
-		Requester:      requester,
+		HostConfig:      hostConfig,
+		RequestBuilder:  requestBuilder,
+		Requester:       requester,
+		DeploymentQueue: &DeploymentQueue{},
 	}
 	connector.RequestBuilder.Init(connector.HostConfig)
 	connector.Requester.Init()
@@ -271,7 +299,7 @@ func (arb *APIRequestBuilder) BuildLoginRequest(rType RequestType, obj entities.
 }
 
 // BuildLoginRequest Build login request
-func (arb *APIRequestBuilder) BuildDeployRequest(ids []int, batchMode string) (req *http.Request, err error) {
+func (arb *APIRequestBuilder) BuildDeployRequest(ids []int, batchMode string, service string) (req *http.Request, err error) {
 	urlObj := url.URL{
 		Scheme: arb.HostConfig.Transport,
 		Host:   arb.HostConfig.Host + ":" + arb.HostConfig.Port,
@@ -286,6 +314,9 @@ func (arb *APIRequestBuilder) BuildDeployRequest(ids []int, batchMode string) (r
 		}
 		payload["batch_mode"] = batchMode
 	}
+	if service != "" {
+		payload["service"] = service
+	}
 	bodyStr, err := json.Marshal(payload)
 	if err != nil {
 		log.Errorf("Cannot marshal deploy request body: %s", err)
@@ -373,6 +404,36 @@ func (c *Connector) GetObject(obj entities.BAMObject, res interface{}) (err erro
 	return
 }
 
+// ListObjects Get the list of objects, returned either as an array or as an object
+// holding the array in the field named after the object type
+func (c *Connector) ListObjects(obj entities.BAMObject, res interface{}) (err error) {
+	log.Debugf("Listing objects %+v", obj)
+	resp, err := c.makeRequest(GET, obj)
+	if err != nil || len(resp) == 0 {
+		return
+	}
+	if !strings.HasPrefix(strings.TrimSpace(string(resp)), "[") {
+		wrapper := make(map[string]json.RawMessage)
+		if err = json.Unmarshal(resp, &wrapper); err != nil {
+			log.Errorf("Cannot unmarshall '%s', err: '%s'", string(resp), err)
+			return
+		}
+		items, ok := wrapper[obj.ObjectType()]
+		if !ok {
+			log.Debugf("No %s in the list response", obj.ObjectType())
+			return
+		}
+		resp = items
+	}
+	err = json.Unmarshal(resp, res)
+	if err != nil {
+		log.Errorf("Cannot unmarshall '%s', err: '%s'", string(resp), err)
+		return
+	}
+	log.Debugf("Completed to list objects")
+	return
+}
+
 // UpdateObject Update the object info
 func (c *Connector) UpdateObject(obj entities.BAMObject, res interface{}) (err error) {
 	log.Debugf("Updating object %+v", obj)
@@ -412,13 +473,18 @@ func (c *Connector) DeleteObject(obj entities.BAMObject) (res string, err error)
 	return
 }
 
-// CreateObject Create the new object
+// DeployObject Selectively deploy the DNS resource records
 func (c *Connector) DeployObject(ids []int, batchMode string) (ref string, err error) {
-	log.Debugf("Deploying object ids %+v with batch_mode %s", ids, batchMode)
+	return c.DeployServiceObject(ids, batchMode, "")
+}
+
+// DeployServiceObject Selectively deploy the objects of the service, e.g. DHCP or DHCPv6
+func (c *Connector) DeployServiceObject(ids []int, batchMode string, service string) (ref string, err error) {
+	log.Debugf("Deploying object ids %+v of service '%s' with batch_mode %s", ids, service, batchMode)
 	ref = ""
 	var req *http.Request
 	var res []byte
-	req, err = c.RequestBuilder.BuildDeployRequest(ids, batchMode)
+	req, err = c.RequestBuilder.BuildDeployRequest(ids, batchMode, service)
 	if err != nil {
 		log.Errorf("Build deploy request error: '%s'", err)
 		return
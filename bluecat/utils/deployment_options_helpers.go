@@ -29,7 +29,7 @@ func CreateDeploymentOptions(objMgr *ObjectManager, target entities.DeploymentOp
 }
 
 // ReadDeploymentOptions reads only the option names already present in config or
-// state, because the BlueCat API exposes item lookups rather than list reads.
+// state, one item lookup each, for the API versions without the list call.
 func ReadDeploymentOptions(objMgr *ObjectManager, target entities.DeploymentOption, configured map[string]string) (map[string]string, error) {
 	optionNames := GetSortedMapKeys(configured)
 	deploymentOptions := make(map[string]string, len(optionNames))
@@ -45,6 +45,38 @@ func ReadDeploymentOptions(objMgr *ObjectManager, target entities.DeploymentOpti
 	return deploymentOptions, nil
 }
 
+// ListDeploymentOptions reads the full set of options on all the servers of the
+// target object, so options added or removed outside Terraform show as drift. With
+// ignoreUnmanaged only the option names of config or state are kept. Falls back to
+// ReadDeploymentOptions when the API has no list call.
+func ListDeploymentOptions(objMgr *ObjectManager, target entities.DeploymentOption, configured map[string]string, ignoreUnmanaged bool) (map[string]string, error) {
+	options, err := objMgr.GetDeploymentOptions(target)
+	if err != nil {
+		if IsNotFoundErr(err) {
+			return ReadDeploymentOptions(objMgr, target, configured)
+		}
+		return nil, err
+	}
+	return FilterDeploymentOptions(options, configured, ignoreUnmanaged), nil
+}
+
+// FilterDeploymentOptions keeps the options assigned to all the servers, which are
+// the ones the deployment_options maps manage. The options scoped to a server belong
+// to bluecat_deployment_option.
+func FilterDeploymentOptions(options []entities.DeploymentOption, configured map[string]string, ignoreUnmanaged bool) map[string]string {
+	deploymentOptions := make(map[string]string, len(options))
+	for _, option := range options {
+		if option.ServerFQDN != "" {
+			continue
+		}
+		if _, ok := configured[option.Name]; ignoreUnmanaged && !ok {
+			continue
+		}
+		deploymentOptions[option.Name] = option.Value
+	}
+	return deploymentOptions
+}
+
 // UpdateDeploymentOptionsForTarget diffs the old and new Terraform maps and
 // applies the necessary create, replace, and delete calls for one target object.
 func UpdateDeploymentOptionsForTarget(objMgr *ObjectManager, target entities.DeploymentOption, currentRaw interface{}, newRaw interface{}) error {
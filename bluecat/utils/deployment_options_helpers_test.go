@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"reflect"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestListDeploymentOptions(t *testing.T) {
+	responses := []string{
+		`[{"name": "allow-query", "value": "any"}, {"name": "allow-transfer", "value": "none", "server_fqdn": "bdds1.example.com"}]`,
+		`{"deployment_options": [{"name": "allow-query", "value": "any"}, {"name": "allow-transfer", "value": "none", "server_fqdn": "bdds1.example.com"}]}`,
+	}
+	for _, response := range responses {
+		requester := &scriptedRequester{responses: []string{response}}
+		objMgr := newTestObjectManager(requester)
+		options, err := objMgr.GetDeploymentOptions(entities.DeploymentOption{
+			Configuration: "Demo",
+			ResourceType:  "network",
+			ResourceRef:   "10.0.0.0/24",
+			IPVersion:     "ipv4",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %s", response, err)
+		}
+		if len(options) != 2 || options[0].ResourceRef != "10.0.0.0/24" {
+			t.Fatalf("expected two options on the network for %s, got %+v", response, options)
+		}
+		if expected := "https://bam:443/api/v1/configurations/Demo/ipv4_networks/10.0.0.0/24/deployment_options/"; requester.lastURL() != expected {
+			t.Errorf("expected the URL %s, got %s", expected, requester.lastURL())
+		}
+		if got := FilterDeploymentOptions(options, map[string]string{}, false); !reflect.DeepEqual(got, map[string]string{"allow-query": "any"}) {
+			t.Errorf("expected only the option on all the servers, got %v", got)
+		}
+	}
+}
+
+func TestFilterDeploymentOptions(t *testing.T) {
+	options := []entities.DeploymentOption{
+		{Name: "allow-query", Value: "any"},
+		{Name: "recursion", Value: "yes"},
+		{Name: "allow-transfer", Value: "none", ServerFQDN: "bdds1.example.com"},
+	}
+	configured := map[string]string{"allow-query": "localhost"}
+	if got := FilterDeploymentOptions(options, configured, false); !reflect.DeepEqual(got, map[string]string{"allow-query": "any", "recursion": "yes"}) {
+		t.Errorf("expected all the options on all the servers, got %v", got)
+	}
+	if got := FilterDeploymentOptions(options, configured, true); !reflect.DeepEqual(got, map[string]string{"allow-query": "any"}) {
+		t.Errorf("expected only the managed options, got %v", got)
+	}
+	if got := FilterDeploymentOptions(nil, configured, false); len(got) != 0 {
+		t.Errorf("expected no options, got %v", got)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package utils
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DeploymentQueue Collects the BAM object IDs touched during an apply, so they can be
+// sent in as few selective deployment requests as possible
+type DeploymentQueue struct {
+	mu      sync.Mutex
+	pending map[DeploymentBatch][]int
+}
+
+// DeploymentBatch The objects deployed together share the service and the batch mode
+type DeploymentBatch struct {
+	Service   string
+	BatchMode string
+}
+
+// Add Queue the object IDs for deployment of the service with the given batch mode.
+// An empty service is the DNS deployment of the resource records.
+func (q *DeploymentQueue) Add(ids []int, batchMode string, service string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.pending == nil {
+		q.pending = make(map[DeploymentBatch][]int)
+	}
+	batch := DeploymentBatch{Service: service, BatchMode: normalizeBatchMode(batchMode)}
+	for _, id := range ids {
+		if id <= 0 || containsID(q.pending[batch], id) {
+			continue
+		}
+		q.pending[batch] = append(q.pending[batch], id)
+	}
+}
+
+// Drain Return the queued object IDs grouped by batch and empty the queue
+func (q *DeploymentQueue) Drain() map[DeploymentBatch][]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	pending := q.pending
+	q.pending = nil
+	for batch := range pending {
+		sort.Ints(pending[batch])
+	}
+	return pending
+}
+
+// Len Return the number of queued object IDs
+func (q *DeploymentQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	count := 0
+	for _, ids := range q.pending {
+		count += len(ids)
+	}
+	return count
+}
+
+// normalizeBatchMode maps the accepted spellings of a batch mode onto one queue key
+func normalizeBatchMode(batchMode string) string {
+	batchMode = strings.ToLower(strings.TrimSpace(batchMode))
+	if batchMode == "true" {
+		return "batch_by_server"
+	}
+	return batchMode
+}
+
+func containsID(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
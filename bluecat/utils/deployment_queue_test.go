@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDeploymentQueueGroupsByServiceAndBatchMode(t *testing.T) {
+	queue := &DeploymentQueue{}
+	queue.Add([]int{3, 1}, "true", "")
+	queue.Add([]int{1, 2}, "batch_by_server", "")
+	queue.Add([]int{5}, "batch_by_server", "DHCP")
+	queue.Add([]int{0}, "disabled", "")
+
+	if queue.Len() != 4 {
+		t.Fatalf("expected 4 queued ids, got %d", queue.Len())
+	}
+	expected := map[DeploymentBatch][]int{
+		{Service: "", BatchMode: "batch_by_server"}:     {1, 2, 3},
+		{Service: "DHCP", BatchMode: "batch_by_server"}: {5},
+	}
+	if pending := queue.Drain(); !reflect.DeepEqual(pending, expected) {
+		t.Fatalf("expected %v, got %v", expected, pending)
+	}
+	if queue.Len() != 0 {
+		t.Fatalf("expected an empty queue after drain, got %d", queue.Len())
+	}
+}
+
+func TestFlushDeploymentQueueDeploysEveryBatch(t *testing.T) {
+	// the batches are deployed in the order of their service, the DHCP one failing
+	requester := &scriptedRequester{responses: []string{`{"token": "abc"}`, "500 deployment of DHCP refused", `{"token": "abc"}`}}
+	objMgr := newTestObjectManager(requester)
+	connector := objMgr.Connector.(*Connector)
+	connector.DeploymentQueue.Add([]int{1}, "", "")
+	connector.DeploymentQueue.Add([]int{2}, "", "DHCP")
+	connector.DeploymentQueue.Add([]int{3}, "", "DHCPv6")
+
+	deployedIDs, status, err := objMgr.FlushDeploymentQueue()
+	if err == nil || !strings.Contains(err.Error(), "[2]") {
+		t.Fatalf("expected the failure of the DHCP batch, got %v", err)
+	}
+	if status != DeploymentFailed {
+		t.Errorf("expected the status %s, got %s", DeploymentFailed, status)
+	}
+	if len(requester.bodies) != 3 || !reflect.DeepEqual(deployedIDs, []int{1, 3}) {
+		t.Errorf("expected the batches after the failed one to be deployed, got %v in %d requests", deployedIDs, len(requester.bodies))
+	}
+	expected := map[DeploymentBatch][]int{{Service: "DHCP", BatchMode: ""}: {2}}
+	if pending := connector.DeploymentQueue.Drain(); !reflect.DeepEqual(pending, expected) {
+		t.Errorf("expected the failed batch to be queued again, got %v", pending)
+	}
+}
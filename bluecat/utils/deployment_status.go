@@ -0,0 +1,133 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"time"
+)
+
+// The deployment states reported to the resources in last_deployment_status
+const (
+	DeploymentQueued    = "QUEUED"
+	DeploymentSubmitted = "SUBMITTED"
+	DeploymentSucceeded = "SUCCESS"
+	DeploymentFailed    = "FAILED"
+	DeploymentTimedOut  = "TIMEOUT"
+)
+
+// DeploymentPollInterval How long to wait between two deployment status requests
+var DeploymentPollInterval = 5 * time.Second
+
+var deploymentSuccessStates = []string{"SUCCESS", "SUCCEEDED", "COMPLETED", "FINISHED", "DONE"}
+var deploymentFailureStates = []string{"FAILED", "FAILURE", "ERROR", "CANCELLED", "CANCELED", "COMPLETED_WITH_ERRORS"}
+
+// ParseDeploymentToken Get the deployment token or ID from the response of the deployment request.
+// The response is either a JSON object holding the token, or the token as a JSON string.
+// Anything else, such as a plain-text status like OK, holds no token.
+func ParseDeploymentToken(res string) string {
+	res = strings.TrimSpace(res)
+	if res == "" {
+		return ""
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(res), &body); err == nil {
+		for _, key := range []string{"token", "deployment_token", "deployment_id", "id"} {
+			switch value := body[key].(type) {
+			case string:
+				if value != "" {
+					return value
+				}
+			case float64:
+				return strconv.FormatInt(int64(value), 10)
+			}
+		}
+		return ""
+	}
+	var token string
+	if err := json.Unmarshal([]byte(res), &token); err == nil {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+// NormalizeDeploymentState Map the deployment state reported by the server onto
+// DeploymentSucceeded, DeploymentFailed or the upper-cased pending state
+func NormalizeDeploymentState(state string) string {
+	state = strings.ToUpper(strings.TrimSpace(state))
+	switch {
+	case containsState(deploymentSuccessStates, state):
+		return DeploymentSucceeded
+	case containsState(deploymentFailureStates, state):
+		return DeploymentFailed
+	default:
+		return state
+	}
+}
+
+// DeploymentFinalState Get the final state of the deployment, or an empty string while it's still running.
+// A deployment with a failed server is failed, even if the overall status says otherwise.
+func DeploymentFinalState(status *entities.DeploymentStatus) string {
+	state := NormalizeDeploymentState(status.Status)
+	for _, server := range status.Servers {
+		if NormalizeDeploymentState(server.Status) == DeploymentFailed {
+			if state == DeploymentSucceeded || state == DeploymentFailed {
+				return DeploymentFailed
+			}
+		}
+	}
+	if state == DeploymentSucceeded || state == DeploymentFailed {
+		return state
+	}
+	return ""
+}
+
+// DeploymentError The error of a failed selective deployment with the per-server, per-object details
+type DeploymentError struct {
+	Token  string
+	Status *entities.DeploymentStatus
+}
+
+func (e *DeploymentError) Error() string {
+	details := make([]string, 0)
+	for _, server := range e.Status.Servers {
+		if NormalizeDeploymentState(server.Status) != DeploymentFailed {
+			continue
+		}
+		detail := fmt.Sprintf("server %s: %s", server.Server, server.Status)
+		if server.Message != "" {
+			detail = fmt.Sprintf("%s (%s)", detail, server.Message)
+		}
+		for _, object := range server.Objects {
+			if NormalizeDeploymentState(object.Status) != DeploymentFailed {
+				continue
+			}
+			detail = fmt.Sprintf("%s; object %d %s: %s", detail, object.ID, object.Name, object.Status)
+			if object.Message != "" {
+				detail = fmt.Sprintf("%s (%s)", detail, object.Message)
+			}
+		}
+		details = append(details, detail)
+	}
+	msg := fmt.Sprintf("deployment %s failed with status %s", e.Token, e.Status.Status)
+	if e.Status.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Status.Message)
+	}
+	if len(details) > 0 {
+		msg = fmt.Sprintf("%s. %s", msg, strings.Join(details, ". "))
+	}
+	return msg
+}
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+	"time"
+)
+
+// statusConnector Returns the given deployment status responses one per request
+type statusConnector struct {
+	responses []string
+}
+
+func (c *statusConnector) CreateObject(obj entities.BAMObject) (string, error) { return "", nil }
+func (c *statusConnector) UpdateObject(obj entities.BAMObject, res interface{}) error {
+	return nil
+}
+func (c *statusConnector) DeleteObject(obj entities.BAMObject) (string, error) { return "", nil }
+func (c *statusConnector) DeployObject(ids []int, batchMode string) (string, error) {
+	return `{"token": "abc"}`, nil
+}
+func (c *statusConnector) DeployServiceObject(ids []int, batchMode string, service string) (string, error) {
+	return c.DeployObject(ids, batchMode)
+}
+func (c *statusConnector) ListObjects(obj entities.BAMObject, res interface{}) error {
+	return nil
+}
+func (c *statusConnector) GetObject(obj entities.BAMObject, res interface{}) error {
+	response := c.responses[0]
+	if len(c.responses) > 1 {
+		c.responses = c.responses[1:]
+	}
+	return json.Unmarshal([]byte(response), res)
+}
+
+func TestParseDeploymentToken(t *testing.T) {
+	cases := map[string]string{
+		`{"token": "abc"}`:      "abc",
+		`{"deployment_id": 42}`: "42",
+		`"abc"`:                 "abc",
+		`abc`:                   "",
+		`OK`:                    "",
+		`SUCCESS`:               "",
+		`Deployment started`:    "",
+		``:                      "",
+	}
+	for res, expected := range cases {
+		if got := ParseDeploymentToken(res); got != expected {
+			t.Errorf("ParseDeploymentToken(%q) = %q, expected %q", res, got, expected)
+		}
+	}
+}
+
+func TestWaitForDeploymentReportsServerFailures(t *testing.T) {
+	DeploymentPollInterval = time.Millisecond
+	objMgr := &ObjectManager{Connector: &statusConnector{responses: []string{
+		`{"status": "RUNNING"}`,
+		`{"status": "COMPLETED", "servers": [
+			{"server": "ns1.example.com", "status": "SUCCESS"},
+			{"server": "ns2.example.com", "status": "FAILED", "message": "unreachable",
+			 "objects": [{"id": 100, "name": "www.example.com", "status": "FAILED", "message": "not deployed"}]}]}`,
+	}}}
+
+	_, err := objMgr.WaitForDeployment("abc", time.Second)
+	if _, ok := err.(*DeploymentError); !ok {
+		t.Fatalf("expected a DeploymentError, got %v", err)
+	}
+	for _, detail := range []string{"ns2.example.com", "unreachable", "object 100 www.example.com", "not deployed"} {
+		if !strings.Contains(err.Error(), detail) {
+			t.Errorf("expected %q in the error: %s", detail, err)
+		}
+	}
+	if strings.Contains(err.Error(), "ns1.example.com") {
+		t.Errorf("the successful server should not be reported: %s", err)
+	}
+}
+
+func TestWaitForDeploymentTimesOut(t *testing.T) {
+	DeploymentPollInterval = time.Millisecond
+	objMgr := &ObjectManager{Connector: &statusConnector{responses: []string{`{"status": "RUNNING"}`}}}
+
+	status, err := objMgr.WaitForDeployment("abc", 5*time.Millisecond)
+	if err == nil || status.Status != "RUNNING" {
+		t.Fatalf("expected a timeout with the last status RUNNING, got %v %v", status, err)
+	}
+}
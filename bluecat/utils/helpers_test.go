@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+)
+
+// scriptedStatus A scripted response standing for a failed request, e.g. "404 Not Found"
+var scriptedStatus = regexp.MustCompile(`^[1-5][0-9]{2} `)
+
+// scriptedRequester Answers the requests with the scripted responses in turn, repeating the last one,
+// and records the request methods, URLs and bodies. A response starting with an HTTP status code,
+// e.g. "404 Not Found", is returned as the error of the request
+type scriptedRequester struct {
+	responses []string
+	methods   []string
+	urls      []string
+	bodies    []string
+}
+
+func (r *scriptedRequester) Init() {}
+func (r *scriptedRequester) SendRequest(req *http.Request) ([]byte, error) {
+	body := []byte{}
+	if req.Body != nil {
+		body, _ = ioutil.ReadAll(req.Body)
+	}
+	r.methods = append(r.methods, req.Method)
+	r.urls = append(r.urls, req.URL.String())
+	r.bodies = append(r.bodies, string(body))
+	response := ""
+	if len(r.responses) > 0 {
+		response = r.responses[0]
+	}
+	if len(r.responses) > 1 {
+		r.responses = r.responses[1:]
+	}
+	if scriptedStatus.MatchString(response) {
+		return nil, errors.New(response)
+	}
+	return []byte(response), nil
+}
+
+// lastURL The URL of the last request, empty if there wasn't any
+func (r *scriptedRequester) lastURL() string {
+	if len(r.urls) == 0 {
+		return ""
+	}
+	return r.urls[len(r.urls)-1]
+}
+
+// newTestObjectManager The object manager sending its requests to the requester, as to https://bam:443/api/v1
+func newTestObjectManager(requester HTTPRequester) *ObjectManager {
+	return &ObjectManager{Connector: &Connector{
+		RequestBuilder:  &APIRequestBuilder{HostConfig: HostConfig{Transport: "https", Host: "bam", Port: "443", Version: "1"}},
+		Requester:       requester,
+		DeploymentQueue: &DeploymentQueue{},
+	}}
+}
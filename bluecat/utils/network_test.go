@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestGetNetworkByAllocatedId(t *testing.T) {
+	cases := []struct {
+		block     string
+		ipVersion string
+		expected  string
+	}{
+		{"10.0.0.0/16", "ipv4", "https://bam:443/api/v1/configurations/Demo/ipv4_blocks/10.0.0.0/16/get_network_by_allocated_id/node-pool-a/"},
+		{"10.0.0.0/16", "", "https://bam:443/api/v1/configurations/Demo/ipv4_blocks/10.0.0.0/16/get_network_by_allocated_id/node-pool-a/"},
+		{"2001:db8::/48", "ipv6", "https://bam:443/api/v1/configurations/Demo/ipv6_blocks/2001:db8::/48/get_network_by_allocated_id/node-pool-a/"},
+	}
+	for _, c := range cases {
+		requester := &scriptedRequester{responses: []string{`{"cidr": "2001:db8:0:1::/64", "allocatedId": "node-pool-a"}`}}
+		objMgr := newTestObjectManager(requester)
+		network, err := objMgr.GetNetworkByAllocatedId("Demo", c.block, "node-pool-a", c.ipVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if requester.lastURL() != c.expected {
+			t.Errorf("expected the URL %s, got %s", c.expected, requester.lastURL())
+		}
+		if network.CIDR != "2001:db8:0:1::/64" {
+			t.Errorf("expected the allocated network, got %+v", network)
+		}
+	}
+}
+
+func TestGetNetworks(t *testing.T) {
+	cases := []struct {
+		block    string
+		expected string
+	}{
+		{"", "https://bam:443/api/v1/configurations/Demo/ipv6_networks/"},
+		{"2001:db8::/48", "https://bam:443/api/v1/configurations/Demo/ipv6_blocks/2001:db8::/48/ipv6_networks/"},
+	}
+	for _, c := range cases {
+		requester := &scriptedRequester{responses: []string{`{"ipv6_networks": [{"cidr": "2001:db8:0:1::/64", "name": "nodes", "usage": {"total": 18446744073709551616, "allocated": 3}}]}`}}
+		objMgr := newTestObjectManager(requester)
+		networks, err := objMgr.GetNetworks("Demo", c.block, "ipv6")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if requester.lastURL() != c.expected {
+			t.Errorf("expected the URL %s, got %s", c.expected, requester.lastURL())
+		}
+		if len(networks) != 1 || networks[0].Usage == nil || networks[0].Usage.Total.String() != "18446744073709551616" {
+			t.Errorf("expected the network with its usage, got %+v", networks)
+		}
+	}
+
+	requester := &scriptedRequester{responses: []string{`[{"start": "10.0.0.10", "end": "10.0.0.99"}]`}}
+	objMgr := newTestObjectManager(requester)
+	ranges, err := objMgr.GetDHCPRanges("Demo", "10.0.0.0/24", "ipv4")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := "https://bam:443/api/v1/configurations/Demo/ipv4_networks/10.0.0.0/24/dhcp_ranges/"; requester.lastURL() != expected {
+		t.Errorf("expected the URL %s, got %s", expected, requester.lastURL())
+	}
+	if len(ranges) != 1 || ranges[0].Start != "10.0.0.10" || ranges[0].End != "10.0.0.99" {
+		t.Errorf("unexpected DHCP ranges %+v", ranges)
+	}
+}
+
+func TestGetNetworkUsage(t *testing.T) {
+	requester := &scriptedRequester{responses: []string{`{"cidr": "10.0.0.0/24", "usage": {"total": 256, "allocated": 40, "static": 30, "reserved": 4, "dhcp_reserved": 6, "dhcp_range": 90, "free": 126, "largest_free_run": 100}}`}}
+	objMgr := newTestObjectManager(requester)
+	usage, err := objMgr.GetNetworkUsage("Demo", "10.0.0.0/24", "ipv4")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := "https://bam:443/api/v1/configurations/Demo/ipv4_networks/10.0.0.0/24/"; requester.lastURL() != expected {
+		t.Errorf("expected the URL %s, got %s", expected, requester.lastURL())
+	}
+	if usage.DHCPReserved.String() != "6" || usage.DHCPRange.String() != "90" || usage.LargestFreeRun.String() != "100" {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+
+	requester.responses = []string{`{"cidr": "10.0.0.0/24"}`}
+	if _, err := objMgr.GetNetworkUsage("Demo", "10.0.0.0/24", "ipv4"); err == nil {
+		t.Errorf("expected an error for a Network without usage")
+	}
+}
+
+func TestGetIPAddresses(t *testing.T) {
+	requester := &scriptedRequester{responses: []string{
+		`{"ipv6_addresses": [{"address": "2001:db8::1", "properties": "state=STATIC|"}, {"address": "2001:db8::2"}]}`,
+		`{"ipv6_addresses": [{"address": "2001:db8::3", "host_records": ["node.example.com"]}]}`,
+	}}
+	objMgr := newTestObjectManager(requester)
+	addresses, err := objMgr.GetIPAddresses("Demo", "2001:db8::/64", "", "", "ipv6", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(addresses) != 3 || addresses[2].HostRecords[0] != "node.example.com" {
+		t.Errorf("expected the addresses of both pages, got %+v", addresses)
+	}
+	expected := []string{`{"offset":0,"limit":2}`, `{"offset":2,"limit":2}`}
+	for i := range expected {
+		if requester.bodies[i] != expected[i] {
+			t.Errorf("expected the page request %s, got %s", expected[i], requester.bodies[i])
+		}
+	}
+}
+
+func TestGetIPAddressesWithoutPaging(t *testing.T) {
+	page := `[{"address": "10.0.0.1"}, {"address": "10.0.0.2"}]`
+	requester := &scriptedRequester{responses: []string{page, page, page}}
+	objMgr := newTestObjectManager(requester)
+	addresses, err := objMgr.GetIPAddresses("Demo", "10.0.0.0/24", "", "", "ipv4", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(addresses) != 2 || len(requester.bodies) != 2 {
+		t.Errorf("expected the listing to stop on the repeated page, got %d addresses in %d requests", len(addresses), len(requester.bodies))
+	}
+
+	requester = &scriptedRequester{responses: []string{page, `[]`}}
+	objMgr.Connector.(*Connector).Requester = requester
+	addresses, err = objMgr.GetIPAddresses("Demo", "10.0.0.0/24", "", "", "ipv4", 2)
+	if err != nil || len(addresses) != 2 {
+		t.Errorf("expected the listing to stop on the empty page, got %d addresses: %v", len(addresses), err)
+	}
+}
+
+func TestGetNextAvailable(t *testing.T) {
+	requester := &scriptedRequester{responses: []string{
+		`["10.0.0.5", "10.0.0.6"]`,
+		`{"next_available_networks": ["2001:db8:0:2::/64"]}`,
+	}}
+	objMgr := newTestObjectManager(requester)
+	ips, err := objMgr.GetNextAvailableIPs(entities.NextAvailable{
+		Configuration: "Demo", Parent: "10.0.0.0/24", IPVersion: "ipv4", Limit: 2, Exclude: []string{"10.0.0.4"}, ExcludeDHCPRange: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ips) != 2 || ips[0] != "10.0.0.5" {
+		t.Errorf("unexpected candidates %v", ips)
+	}
+	if expected := "https://bam:443/api/v1/configurations/Demo/ipv4_networks/10.0.0.0/24/next_available_ips/"; requester.urls[0] != expected {
+		t.Errorf("expected the URL %s, got %s", expected, requester.urls[0])
+	}
+	if expected := `{"limit":2,"exclude":["10.0.0.4"],"exclude_dhcp_range":true}`; requester.bodies[0] != expected {
+		t.Errorf("expected the request %s, got %s", expected, requester.bodies[0])
+	}
+
+	networks, err := objMgr.GetNextAvailableNetworks(entities.NextAvailable{
+		Configuration: "Demo", Parent: "2001:db8::/48", IPVersion: "ipv6", Size: "64", Limit: 1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(networks) != 1 || networks[0] != "2001:db8:0:2::/64" {
+		t.Errorf("unexpected candidates %v", networks)
+	}
+	if expected := "https://bam:443/api/v1/configurations/Demo/ipv6_blocks/2001:db8::/48/next_available_networks/"; requester.urls[1] != expected {
+		t.Errorf("expected the URL %s, got %s", expected, requester.urls[1])
+	}
+	if expected := `{"size":"64","limit":1}`; requester.bodies[1] != expected {
+		t.Errorf("expected the request %s, got %s", expected, requester.bodies[1])
+	}
+}
@@ -5,10 +5,12 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"terraform-provider-bluecat/bluecat/entities"
 	"terraform-provider-bluecat/bluecat/models"
+	"time"
 )
 
 var nextAvailableBlockMu sync.Mutex
@@ -22,13 +24,14 @@ type ObjectManager struct {
 // Host record
 
 // CreateHostRecord Create the Host record
-func (objMgr *ObjectManager) CreateHostRecord(configuration string, view string, zone string, absoluteName string, ip4Address string, ttl int, properties string) (*entities.HostRecord, error) {
+func (objMgr *ObjectManager) CreateHostRecord(configuration string, view string, zone string, absoluteName string, ip4Address string, reverseRecord string, ttl int, properties string) (*entities.HostRecord, error) {
 
 	hostRecord := models.NewHostRecord(entities.HostRecord{
 		Configuration: configuration,
 		View:          view,
 		Zone:          zone,
 		IP4Address:    ip4Address,
+		ReverseRecord: reverseRecord,
 		AbsoluteName:  absoluteName,
 		TTL:           ttl,
 		Properties:    properties,
@@ -57,13 +60,14 @@ func (objMgr *ObjectManager) GetHostRecord(configuration string, view string, ab
 }
 
 // UpdateHostRecord Update the Host record
-func (objMgr *ObjectManager) UpdateHostRecord(configuration string, view string, zone string, absoluteName string, ip4Address string, ttl int, properties string) (*entities.HostRecord, error) {
+func (objMgr *ObjectManager) UpdateHostRecord(configuration string, view string, zone string, absoluteName string, ip4Address string, reverseRecord string, ttl int, properties string) (*entities.HostRecord, error) {
 
 	hostRecord := models.HostRecord(entities.HostRecord{
 		Configuration: configuration,
 		View:          view,
 		Zone:          zone,
 		IP4Address:    ip4Address,
+		ReverseRecord: reverseRecord,
 		AbsoluteName:  absoluteName,
 		TTL:           ttl,
 		Properties:    properties,
@@ -262,6 +266,18 @@ func (objMgr *ObjectManager) GetBlock(configuration string, address string, cidr
 	return block, err
 }
 
+// GetBlockUsage Get the address counts of the Block
+func (objMgr *ObjectManager) GetBlockUsage(configuration string, address string, cidr string, ipVersion string) (*entities.AddressUsage, error) {
+	block, err := objMgr.GetBlock(configuration, address, cidr, ipVersion)
+	if err != nil {
+		return nil, err
+	}
+	if block.Usage == nil {
+		return nil, fmt.Errorf("no address usage returned for the Block %s/%s", address, cidr)
+	}
+	return block.Usage, nil
+}
+
 // UpdateBlock Update the Block info
 func (objMgr *ObjectManager) UpdateBlock(block entities.Block) (*entities.Block, error) {
 
@@ -375,13 +391,52 @@ func (objMgr *ObjectManager) GetNetwork(network *entities.Network) (*entities.Ne
 	return network, err
 }
 
+// GetNetworkByAddress Get the Network containing the IP address. As for the Blocks, the CIDR 0 stands for the containing one
+func (objMgr *ObjectManager) GetNetworkByAddress(configuration string, address string, ipVersion string) (*entities.Network, error) {
+	return objMgr.GetNetwork(&entities.Network{
+		Configuration: configuration,
+		CIDR:          fmt.Sprintf("%s/0", address),
+		IPVersion:     ipVersion,
+	})
+}
+
+// GetNetworks Get the Networks of the Configuration, or of the Block if it is given
+func (objMgr *ObjectManager) GetNetworks(configuration string, block string, ipVersion string) ([]entities.Network, error) {
+	networksEntity := models.Networks(entities.Network{
+		Configuration: configuration,
+		BlockAddr:     block,
+		IPVersion:     ipVersion,
+	})
+
+	networks := make([]entities.Network, 0)
+	err := objMgr.Connector.ListObjects(networksEntity, &networks)
+	return networks, err
+}
+
+// GetNetworkUsage Get the address counts of the Network
+func (objMgr *ObjectManager) GetNetworkUsage(configuration string, cidr string, ipVersion string) (*entities.AddressUsage, error) {
+	network, err := objMgr.GetNetwork(&entities.Network{
+		Configuration: configuration,
+		CIDR:          cidr,
+		IPVersion:     ipVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if network.Usage == nil {
+		return nil, fmt.Errorf("no address usage returned for the Network %s", cidr)
+	}
+	return network.Usage, nil
+}
+
 // GetNetworkByAllocatedId Get the Network info by allocated id
-func (objMgr *ObjectManager) GetNetworkByAllocatedId(configuration string, block string, allocatedId string) (*entities.Network, error) {
+func (objMgr *ObjectManager) GetNetworkByAllocatedId(configuration string, block string, allocatedId string, ipVersion string) (*entities.Network, error) {
 
-	network := models.Network(entities.Network{
+	network := models.NetworkByAllocatedId(entities.Network{
 		Configuration: configuration,
 		BlockAddr:     block,
 		AllocatedId:   allocatedId,
+		IPVersion:     ipVersion,
 	})
 
 	err := objMgr.Connector.GetObject(network, &network)
@@ -463,6 +518,19 @@ func (objMgr *ObjectManager) UpdateDHCPRange(dhcpRange entities.DHCPRange) (*ent
 	return dhcpRangeEntity, err
 }
 
+// GetDHCPRanges Get the DHCP Ranges of the Network
+func (objMgr *ObjectManager) GetDHCPRanges(configuration string, network string, ipVersion string) ([]entities.DHCPRange, error) {
+	dhcpRangesEntity := models.DHCPRanges(entities.DHCPRange{
+		Configuration: configuration,
+		Network:       network,
+		IPVersion:     ipVersion,
+	})
+
+	dhcpRanges := make([]entities.DHCPRange, 0)
+	err := objMgr.Connector.ListObjects(dhcpRangesEntity, &dhcpRanges)
+	return dhcpRanges, err
+}
+
 // DeleteDHCPRange Delete the DHCP Range
 func (objMgr *ObjectManager) DeleteDHCPRange(dhcpRange entities.DHCPRange) (string, error) {
 	dhcpRangeEntity := models.DHCPRange(dhcpRange)
@@ -523,6 +591,97 @@ func (objMgr *ObjectManager) GetIPAddress(configuration string, address string,
 	return ipAddr, err
 }
 
+// GetIPAddressByAllocationToken Get the IP Address allocated in the network with the allocation token
+func (objMgr *ObjectManager) GetIPAddressByAllocationToken(configuration string, network string, token string, ipVersion string) (*entities.IPAddress, error) {
+
+	ipAddr := models.IPAddressByAllocationToken(entities.IPAddress{
+		Configuration:   configuration,
+		CIDR:            network,
+		AllocationToken: token,
+		IPVersion:       ipVersion,
+	})
+
+	err := objMgr.Connector.GetObject(ipAddr, &ipAddr)
+	return ipAddr, err
+}
+
+// GetIPAddressByMAC Get the IP Address of the MAC address in the network
+func (objMgr *ObjectManager) GetIPAddressByMAC(configuration string, network string, mac string, ipVersion string) (*entities.IPAddress, error) {
+
+	ipAddr := models.IPAddressByMAC(entities.IPAddress{
+		Configuration: configuration,
+		CIDR:          network,
+		Mac:           mac,
+		IPVersion:     ipVersion,
+	})
+
+	err := objMgr.Connector.GetObject(ipAddr, &ipAddr)
+	return ipAddr, err
+}
+
+// GetNextAvailableIPs Get the next available addresses of the network, without reserving them
+func (objMgr *ObjectManager) GetNextAvailableIPs(next entities.NextAvailable) ([]string, error) {
+	return objMgr.listNextAvailable(models.NextAvailableIPs(next))
+}
+
+// GetNextAvailableNetworks Get the next available Networks of the Block, without creating them
+func (objMgr *ObjectManager) GetNextAvailableNetworks(next entities.NextAvailable) ([]string, error) {
+	return objMgr.listNextAvailable(models.NextAvailableNetworks(next))
+}
+
+// GetNextAvailableBlocks Get the next available Blocks of the Block, without creating them
+func (objMgr *ObjectManager) GetNextAvailableBlocks(next entities.NextAvailable) ([]string, error) {
+	return objMgr.listNextAvailable(models.NextAvailableBlocks(next))
+}
+
+func (objMgr *ObjectManager) listNextAvailable(next *entities.NextAvailable) ([]string, error) {
+	candidates := make([]string, 0)
+	err := objMgr.Connector.ListObjects(next, &candidates)
+	return candidates, err
+}
+
+// maxIPAddressPages The most pages of addresses listed, in case the Gateway keeps returning full pages
+const maxIPAddressPages = 1000
+
+// GetIPAddresses Get the assigned IP Addresses of the network, from start to end if given,
+// going through the pages of pageSize addresses until a short one. A Gateway ignoring the paging
+// returns the same page again, which ends the listing as well
+func (objMgr *ObjectManager) GetIPAddresses(configuration string, network string, start string, end string, ipVersion string, pageSize int) ([]entities.IPAddress, error) {
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("the page size must be positive, got %d", pageSize)
+	}
+	addresses := make([]entities.IPAddress, 0)
+	previousFirst := ""
+	for pageNumber := 0; pageNumber < maxIPAddressPages; pageNumber++ {
+		page := models.IPAddresses(entities.IPAddressPage{
+			Configuration: configuration,
+			Network:       network,
+			IPVersion:     ipVersion,
+			Start:         start,
+			End:           end,
+			Offset:        len(addresses),
+			Limit:         pageSize,
+		})
+		pageAddresses := make([]entities.IPAddress, 0)
+		if err := objMgr.Connector.ListObjects(page, &pageAddresses); err != nil {
+			return nil, err
+		}
+		if len(pageAddresses) == 0 {
+			return addresses, nil
+		}
+		if pageAddresses[0].Address == previousFirst {
+			log.Warnf("The page %d of the IP addresses of the network %s repeats the previous one, the Gateway may not support paging", pageNumber+1, network)
+			return addresses, nil
+		}
+		previousFirst = pageAddresses[0].Address
+		addresses = append(addresses, pageAddresses...)
+		if len(pageAddresses) < pageSize {
+			return addresses, nil
+		}
+	}
+	return nil, fmt.Errorf("the IP addresses of the network %s span more than %d pages of %d addresses", network, maxIPAddressPages, pageSize)
+}
+
 // SetMACAddress Update the MAC address for the existing IP address
 func (objMgr *ObjectManager) SetMACAddress(address entities.IPAddress) (*entities.IPAddress, error) {
 	address.Properties = ""
@@ -679,6 +838,53 @@ func (objMgr *ObjectManager) DeleteGenericRecord(configuration string, view stri
 	return objMgr.Connector.DeleteObject(genericRecord)
 }
 
+// GetResourceRecords Get all the resource records of the name and the type
+func (objMgr *ObjectManager) GetResourceRecords(configuration string, view string, zone string, absoluteName string, typerr string) (*entities.ResourceRecords, error) {
+
+	records := models.ResourceRecords(entities.ResourceRecords{
+		Configuration: configuration,
+		View:          view,
+		Zone:          zone,
+		AbsoluteName:  absoluteName,
+		TypeRR:        typerr,
+	})
+
+	err := objMgr.Connector.GetObject(records, &records)
+	return records, err
+}
+
+// GetZoneResourceRecords Get all the resource records of the zone
+func (objMgr *ObjectManager) GetZoneResourceRecords(configuration string, view string, zone string) (*entities.ResourceRecords, error) {
+	return objMgr.GetResourceRecords(configuration, view, zone, "", "")
+}
+
+// UpdateResourceRecord Update the data and the TTL of the resource record by its BAM ID
+func (objMgr *ObjectManager) UpdateResourceRecord(configuration string, view string, id int, data string, ttl int) (*entities.GenericRecord, error) {
+
+	record := models.ResourceRecord(entities.GenericRecord{
+		Configuration: configuration,
+		View:          view,
+		BAMId:         id,
+		Data:          data,
+		TTL:           ttl,
+	})
+
+	err := objMgr.Connector.UpdateObject(record, &record)
+	return record, err
+}
+
+// DeleteResourceRecord Delete the resource record by its BAM ID
+func (objMgr *ObjectManager) DeleteResourceRecord(configuration string, view string, id int) (string, error) {
+
+	record := models.ResourceRecord(entities.GenericRecord{
+		Configuration: configuration,
+		View:          view,
+		BAMId:         id,
+	})
+
+	return objMgr.Connector.DeleteObject(record)
+}
+
 // CreateSRVRecord Create the SRV record
 func (objMgr *ObjectManager) CreateSRVRecord(configuration string, view string, zone string, priority int, port int, weight int, absoluteName string, linkedRecord string, ttl int, properties string) (*entities.SRVRecord, error) {
 
@@ -750,6 +956,75 @@ func (objMgr *ObjectManager) DeleteSRVRecord(configuration string, view string,
 	return objMgr.Connector.DeleteObject(srvRecord)
 }
 
+// CreateMXRecord Create the MX record
+func (objMgr *ObjectManager) CreateMXRecord(configuration string, view string, zone string, priority int, absoluteName string, linkedRecord string, ttl int, properties string) (*entities.MXRecord, error) {
+
+	mxRecord := models.NewMXRecord(entities.MXRecord{
+		Configuration: configuration,
+		View:          view,
+		Zone:          zone,
+		LinkedRecord:  linkedRecord,
+		Priority:      priority,
+		AbsoluteName:  absoluteName,
+		TTL:           ttl,
+		Properties:    properties,
+	})
+
+	res, err := objMgr.Connector.CreateObject(mxRecord)
+	if err == nil {
+		var respDict map[string]interface{}
+		_ = json.Unmarshal([]byte(res), &respDict)
+		if id, ok := respDict["id"].(float64); ok {
+			mxRecord.BAMId = int(id)
+		}
+	}
+	return mxRecord, err
+}
+
+// GetMXRecord Get the MX record
+func (objMgr *ObjectManager) GetMXRecord(configuration string, view string, absoluteName string) (*entities.MXRecord, error) {
+
+	mxRecord := models.MXRecord(entities.MXRecord{
+		Configuration: configuration,
+		View:          view,
+		AbsoluteName:  absoluteName,
+	})
+
+	err := objMgr.Connector.GetObject(mxRecord, &mxRecord)
+	return mxRecord, err
+}
+
+// UpdateMXRecord Update the MX record
+func (objMgr *ObjectManager) UpdateMXRecord(configuration string, view string, zone string, priority int, absoluteName string, linkedRecord string, ttl int, properties string, name string) (*entities.MXRecord, error) {
+
+	mxRecord := models.MXRecord(entities.MXRecord{
+		Configuration: configuration,
+		View:          view,
+		Zone:          zone,
+		LinkedRecord:  linkedRecord,
+		Priority:      priority,
+		AbsoluteName:  absoluteName,
+		TTL:           ttl,
+		Properties:    properties,
+		Name:          name,
+	})
+
+	err := objMgr.Connector.UpdateObject(mxRecord, &mxRecord)
+	return mxRecord, err
+}
+
+// DeleteMXRecord Delete the MX record
+func (objMgr *ObjectManager) DeleteMXRecord(configuration string, view string, absoluteName string) (string, error) {
+
+	mxRecord := models.MXRecord(entities.MXRecord{
+		Configuration: configuration,
+		View:          view,
+		AbsoluteName:  absoluteName,
+	})
+
+	return objMgr.Connector.DeleteObject(mxRecord)
+}
+
 // CreateExternalHostRecord Create the External Host Record record
 func (objMgr *ObjectManager) CreateExternalHostRecord(configuration string, view string, addresses string, absoluteName string, properties string) (*entities.ExternalHostRecord, error) {
 
@@ -866,10 +1141,9 @@ func (objMgr *ObjectManager) DeleteZone(configuration string, view string, zone
 
 // Deployment role
 
-// CreateDeploymentRole Create the Deployment role
+// CreateDeploymentRole Create the Deployment role on the zone, or on the view if the zone is not provided
 func (objMgr *ObjectManager) CreateDeploymentRole(configuration string, view string, zone string, serverFQDN string, roleType string, role string, properties string, secondaryFQDN string) (*entities.DeploymentRole, error) {
-
-	deploymentRole := models.NewDeploymentRole(entities.DeploymentRole{
+	return objMgr.CreateObjectDeploymentRole(entities.DeploymentRole{
 		Configuration: configuration,
 		View:          view,
 		Zone:          zone,
@@ -879,29 +1153,21 @@ func (objMgr *ObjectManager) CreateDeploymentRole(configuration string, view str
 		Properties:    properties,
 		SecondaryFQDN: secondaryFQDN,
 	})
-
-	_, err := objMgr.Connector.CreateObject(deploymentRole)
-	return deploymentRole, err
 }
 
-// GetDeploymentRole Get the Deployment role
+// GetDeploymentRole Get the Deployment role on the zone, or on the view if the zone is not provided
 func (objMgr *ObjectManager) GetDeploymentRole(configuration string, view string, zone string, serverFQDN string) (*entities.DeploymentRole, error) {
-
-	deploymentRole := models.DeploymentRole(entities.DeploymentRole{
+	return objMgr.GetObjectDeploymentRole(entities.DeploymentRole{
 		Configuration: configuration,
 		View:          view,
 		Zone:          zone,
 		ServerFQDN:    serverFQDN,
 	})
-
-	err := objMgr.Connector.GetObject(deploymentRole, &deploymentRole)
-	return deploymentRole, err
 }
 
-// UpdateDeploymentRole Update the Deployment role
+// UpdateDeploymentRole Update the Deployment role on the zone, or on the view if the zone is not provided
 func (objMgr *ObjectManager) UpdateDeploymentRole(configuration string, view string, zone string, serverFQDN string, roleType string, role string, properties string, secondaryFQDN string) (*entities.DeploymentRole, error) {
-
-	deploymentRole := models.DeploymentRole(entities.DeploymentRole{
+	return objMgr.UpdateObjectDeploymentRole(entities.DeploymentRole{
 		Configuration: configuration,
 		View:          view,
 		Zone:          zone,
@@ -911,22 +1177,47 @@ func (objMgr *ObjectManager) UpdateDeploymentRole(configuration string, view str
 		Properties:    properties,
 		SecondaryFQDN: secondaryFQDN,
 	})
-
-	err := objMgr.Connector.UpdateObject(deploymentRole, &deploymentRole)
-	return deploymentRole, err
 }
 
-// DeleteDeploymentRole Delete the Deployment role
+// DeleteDeploymentRole Delete the Deployment role on the zone, or on the view if the zone is not provided
 func (objMgr *ObjectManager) DeleteDeploymentRole(configuration string, view string, zone string, serverFQDN string) (string, error) {
-
-	deploymentRole := models.DeploymentRole(entities.DeploymentRole{
+	return objMgr.DeleteObjectDeploymentRole(entities.DeploymentRole{
 		Configuration: configuration,
 		View:          view,
 		Zone:          zone,
 		ServerFQDN:    serverFQDN,
 	})
+}
+
+// CreateObjectDeploymentRole Create the Deployment role on the zone, view, block or network of the entity
+func (objMgr *ObjectManager) CreateObjectDeploymentRole(deploymentRole entities.DeploymentRole) (*entities.DeploymentRole, error) {
+	deploymentRoleObj := models.NewDeploymentRole(deploymentRole)
+
+	_, err := objMgr.Connector.CreateObject(deploymentRoleObj)
+	return deploymentRoleObj, err
+}
+
+// GetObjectDeploymentRole Get the Deployment role on the zone, view, block or network of the entity
+func (objMgr *ObjectManager) GetObjectDeploymentRole(deploymentRole entities.DeploymentRole) (*entities.DeploymentRole, error) {
+	deploymentRoleObj := models.DeploymentRole(deploymentRole)
+
+	err := objMgr.Connector.GetObject(deploymentRoleObj, &deploymentRoleObj)
+	return deploymentRoleObj, err
+}
+
+// UpdateObjectDeploymentRole Update the Deployment role on the zone, view, block or network of the entity
+func (objMgr *ObjectManager) UpdateObjectDeploymentRole(deploymentRole entities.DeploymentRole) (*entities.DeploymentRole, error) {
+	deploymentRoleObj := models.DeploymentRole(deploymentRole)
+
+	err := objMgr.Connector.UpdateObject(deploymentRoleObj, &deploymentRoleObj)
+	return deploymentRoleObj, err
+}
 
-	return objMgr.Connector.DeleteObject(deploymentRole)
+// DeleteObjectDeploymentRole Delete the Deployment role on the zone, view, block or network of the entity
+func (objMgr *ObjectManager) DeleteObjectDeploymentRole(deploymentRole entities.DeploymentRole) (string, error) {
+	deploymentRoleObj := models.DeploymentRole(deploymentRole)
+
+	return objMgr.Connector.DeleteObject(deploymentRoleObj)
 }
 
 // CreateDeploymentOption Create the Deployment option
@@ -944,6 +1235,25 @@ func (objMgr *ObjectManager) GetDeploymentOption(deploymentOption entities.Deplo
 	return objMgr.getDeploymentOptionResponse(GET, deploymentOptionObj)
 }
 
+// GetDeploymentOptions Get all the Deployment options on the zone, view, block, network or IP address of the entity
+func (objMgr *ObjectManager) GetDeploymentOptions(deploymentOption entities.DeploymentOption) ([]entities.DeploymentOption, error) {
+	deploymentOptionsObj := models.DeploymentOptions(deploymentOption)
+
+	options := make([]entities.DeploymentOption, 0)
+	if err := objMgr.Connector.ListObjects(deploymentOptionsObj, &options); err != nil {
+		return nil, err
+	}
+	for i := range options {
+		options[i].Configuration = deploymentOption.Configuration
+		options[i].View = deploymentOption.View
+		options[i].Zone = deploymentOption.Zone
+		options[i].ResourceType = deploymentOption.ResourceType
+		options[i].ResourceRef = deploymentOption.ResourceRef
+		options[i].IPVersion = deploymentOption.IPVersion
+	}
+	return options, nil
+}
+
 // UpdateDeploymentOption Update the Deployment option
 func (objMgr *ObjectManager) UpdateDeploymentOption(deploymentOption entities.DeploymentOption) (*entities.DeploymentOption, error) {
 	deploymentOptionObj := models.DeploymentOption(deploymentOption)
@@ -985,6 +1295,9 @@ func (objMgr *ObjectManager) getDeploymentOptionResponse(rType RequestType, depl
 		option.Configuration = deploymentOption.Configuration
 		option.View = deploymentOption.View
 		option.Zone = deploymentOption.Zone
+		option.ResourceType = deploymentOption.ResourceType
+		option.ResourceRef = deploymentOption.ResourceRef
+		option.IPVersion = deploymentOption.IPVersion
 		option.ServerID = deploymentOption.ServerID
 		return &option, nil
 	}
@@ -995,6 +1308,162 @@ func (objMgr *ObjectManager) getDeploymentOptionResponse(rType RequestType, depl
 	return deploymentOption, nil
 }
 
+// Deployment
+
+// DeployObjects Deploy the DNS resource records, or queue them for a later flush when deferred deployment is enabled.
+// Returns the deployment status to be stored in last_deployment_status.
+func (objMgr *ObjectManager) DeployObjects(ids []int, batchMode string) (string, error) {
+	return objMgr.DeployServiceObjects(ids, batchMode, "")
+}
+
+// DeployServiceObjects Deploy the objects of the service, or queue them when deferred deployment is enabled
+func (objMgr *ObjectManager) DeployServiceObjects(ids []int, batchMode string, service string) (string, error) {
+	connector, ok := objMgr.Connector.(*Connector)
+	if ok && connector.DeferDeployment {
+		connector.DeploymentQueue.Add(ids, batchMode, service)
+		log.Debugf("Queued object ids %+v of service '%s' for deployment with batch_mode %s", ids, service, batchMode)
+		return DeploymentQueued, nil
+	}
+	return objMgr.DeployServiceAndWait(ids, batchMode, service)
+}
+
+// DeployAndWait Deploy the DNS resource records right away and wait until the deployment succeeds, fails or times out
+func (objMgr *ObjectManager) DeployAndWait(ids []int, batchMode string) (string, error) {
+	return objMgr.DeployServiceAndWait(ids, batchMode, "")
+}
+
+// DeployServiceAndWait Deploy the objects of the service right away and wait until the deployment succeeds, fails or times out
+func (objMgr *ObjectManager) DeployServiceAndWait(ids []int, batchMode string, service string) (string, error) {
+	res, err := objMgr.Connector.DeployServiceObject(ids, batchMode, service)
+	if err != nil {
+		return DeploymentFailed, err
+	}
+	return objMgr.waitForDeploymentResponse(res, fmt.Sprintf("object ids %+v", ids))
+}
+
+// waitForDeploymentResponse Wait for the deployment requested with the given response, if the provider waits for deployments
+func (objMgr *ObjectManager) waitForDeploymentResponse(res string, deployed string) (string, error) {
+	var timeout time.Duration
+	if connector, ok := objMgr.Connector.(*Connector); ok {
+		timeout = connector.DeploymentTimeout
+	}
+	if timeout <= 0 {
+		return DeploymentSubmitted, nil
+	}
+	token := ParseDeploymentToken(res)
+	if token == "" {
+		log.Warnf("No deployment token in the response '%s', can't wait for the deployment of %s", res, deployed)
+		return DeploymentSubmitted, nil
+	}
+	status, err := objMgr.WaitForDeployment(token, timeout)
+	if err != nil {
+		if _, failed := err.(*DeploymentError); failed {
+			return DeploymentFailed, err
+		}
+		return DeploymentTimedOut, err
+	}
+	log.Debugf("Deployment %s of %s completed with status %s", token, deployed, status.Status)
+	return DeploymentSucceeded, nil
+}
+
+// DeployServer Run the full or quick deployment of the service on the server, and wait for the result
+func (objMgr *ObjectManager) DeployServer(configuration string, serverFQDN string, deploymentType string, service string) (string, error) {
+	server, err := objMgr.GetServerByFQDN(configuration, serverFQDN)
+	if err != nil {
+		return "", fmt.Errorf("getting server %s failed: %s", serverFQDN, err)
+	}
+	serverDeployment := models.NewServerDeployment(entities.ServerDeployment{
+		Configuration:  configuration,
+		ServerID:       server.ServerId,
+		DeploymentType: deploymentType,
+		Services:       []string{service},
+	})
+	res, err := objMgr.Connector.CreateObject(serverDeployment)
+	if err != nil {
+		return DeploymentFailed, err
+	}
+	return objMgr.waitForDeploymentResponse(res, fmt.Sprintf("server %s", serverFQDN))
+}
+
+// GetDeploymentStatus Get the status of the selective deployment
+func (objMgr *ObjectManager) GetDeploymentStatus(token string) (*entities.DeploymentStatus, error) {
+	status := models.DeploymentStatus(entities.DeploymentStatus{
+		Token: token,
+	})
+	err := objMgr.Connector.GetObject(status, &status)
+	return status, err
+}
+
+// WaitForDeployment Poll the deployment status until it succeeds, fails or the timeout expires.
+// A failed deployment returns a *DeploymentError with the per-server and per-object failures.
+func (objMgr *ObjectManager) WaitForDeployment(token string, timeout time.Duration) (*entities.DeploymentStatus, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := objMgr.GetDeploymentStatus(token)
+		if err != nil {
+			return nil, fmt.Errorf("getting the status of deployment %s failed: %s", token, err)
+		}
+		switch DeploymentFinalState(status) {
+		case DeploymentSucceeded:
+			return status, nil
+		case DeploymentFailed:
+			return status, &DeploymentError{Token: token, Status: status}
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return status, fmt.Errorf("deployment %s didn't complete within %s, the last status is %s", token, timeout, status.Status)
+		}
+		log.Debugf("Deployment %s is %s, checking again", token, status.Status)
+		if remaining > DeploymentPollInterval {
+			remaining = DeploymentPollInterval
+		}
+		time.Sleep(remaining)
+	}
+}
+
+// FlushDeploymentQueue Deploy all queued objects with one deployment request per service and batch mode.
+// Every batch is deployed even if another one fails; the failed batches go back to the queue.
+// Returns the deployed object IDs and the status of the deployments, the failed one if any.
+func (objMgr *ObjectManager) FlushDeploymentQueue() ([]int, string, error) {
+	connector, ok := objMgr.Connector.(*Connector)
+	if !ok {
+		return nil, "", fmt.Errorf("deployment queue requires *utils.Connector")
+	}
+	pending := connector.DeploymentQueue.Drain()
+	batches := make([]DeploymentBatch, 0, len(pending))
+	for batch := range pending {
+		batches = append(batches, batch)
+	}
+	sort.Slice(batches, func(i, j int) bool {
+		if batches[i].Service != batches[j].Service {
+			return batches[i].Service < batches[j].Service
+		}
+		return batches[i].BatchMode < batches[j].BatchMode
+	})
+
+	deployedIDs := make([]int, 0)
+	lastStatus, failedStatus := "", ""
+	failures := make([]string, 0)
+	for _, batch := range batches {
+		status, err := objMgr.DeployServiceAndWait(pending[batch], batch.BatchMode, batch.Service)
+		if err != nil {
+			connector.DeploymentQueue.Add(pending[batch], batch.BatchMode, batch.Service)
+			failures = append(failures, fmt.Sprintf("deploying object ids %v of service '%s' with batch_mode %s failed: %s", pending[batch], batch.Service, batch.BatchMode, err))
+			if failedStatus == "" {
+				failedStatus = status
+			}
+			continue
+		}
+		log.Debugf("Successfully deployed object ids %v. %s", pending[batch], status)
+		deployedIDs = append(deployedIDs, pending[batch]...)
+		lastStatus = status
+	}
+	if len(failures) > 0 {
+		return deployedIDs, failedStatus, fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return deployedIDs, lastStatus, nil
+}
+
 // GetServer Get the Server info
 func (objMgr *ObjectManager) GetServerByFQDN(configuration string, serverFQDN string) (*entities.Server, error) {
 
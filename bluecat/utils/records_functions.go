@@ -175,3 +175,16 @@ func JoinProperties(m map[string]string) string {
 	}
 	return strings.Join(parts, "|")
 }
+
+// ExpandStringList Get the non-empty strings of a list attribute
+func ExpandStringList(v interface{}) []string {
+	raw, _ := v.([]interface{})
+	out := make([]string, 0, len(raw))
+	for _, value := range raw {
+		if s, ok := value.(string); ok && s != "" {
+			out = append(out, s)
+		}
+	}
+
+	return out
+}
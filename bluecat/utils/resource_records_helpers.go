@@ -0,0 +1,94 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package utils
+
+import (
+	"strings"
+	"sync"
+	"terraform-provider-bluecat/bluecat/entities"
+)
+
+// resourceRecordsSupport Whether or not the Gateway of each connector has the resource_records endpoints, once probed
+var resourceRecordsSupport sync.Map
+
+// ResourceRecordsSupported tells whether or not the Gateway has the resource_records endpoints, which only
+// exist on the Gateway versions shipping them. The records of the zone are probed once per connector: a 404
+// while the zone exists means the endpoints are missing. A 404 of the zone itself is returned as is.
+func ResourceRecordsSupported(objMgr *ObjectManager, configuration string, view string, zone string) (bool, error) {
+	if supported, ok := resourceRecordsSupport.Load(objMgr.Connector); ok {
+		return supported.(bool), nil
+	}
+	supported := true
+	if _, err := objMgr.GetZoneResourceRecords(configuration, view, zone); err != nil {
+		if !IsNotFoundErr(err) {
+			return false, err
+		}
+		if _, err := objMgr.GetZone(configuration, view, zone); err != nil {
+			return false, err
+		}
+		log.Warnf("The Gateway has no resource_records endpoints, the records are managed one name at a time")
+		supported = false
+	}
+	resourceRecordsSupport.Store(objMgr.Connector, supported)
+	return supported, nil
+}
+
+// ListResourceRecords reads the resource records of the name and the type, none if there aren't any.
+// Without the resource_records endpoints, it falls back to the Generic record of the name, one item
+// lookup, and byName tells the records must then be deleted through DeleteResourceRecords by name.
+func ListResourceRecords(objMgr *ObjectManager, configuration string, view string, zone string, absoluteName string, typerr string) (records []entities.GenericRecord, byName bool, err error) {
+	supported, err := ResourceRecordsSupported(objMgr, configuration, view, zone)
+	if err != nil {
+		return nil, false, err
+	}
+	if !supported {
+		record, err := objMgr.GetGenericRecord(configuration, view, absoluteName)
+		if err != nil {
+			if IsNotFoundErr(err) {
+				return []entities.GenericRecord{}, true, nil
+			}
+			return nil, true, err
+		}
+		return filterResourceRecords([]entities.GenericRecord{*record}, typerr), true, nil
+	}
+	list, err := objMgr.GetResourceRecords(configuration, view, zone, absoluteName, typerr)
+	if err != nil {
+		if IsNotFoundErr(err) {
+			return []entities.GenericRecord{}, false, nil
+		}
+		return nil, false, err
+	}
+	return filterResourceRecords(list.Records, typerr), false, nil
+}
+
+// DeleteResourceRecords deletes the resource records by their BAM IDs, or by the name
+// when they were read without the resource_records endpoints. The records already gone
+// are skipped.
+func DeleteResourceRecords(objMgr *ObjectManager, configuration string, view string, absoluteName string, records []entities.GenericRecord, byName bool) error {
+	if byName {
+		if len(records) == 0 {
+			return nil
+		}
+		if _, err := objMgr.DeleteGenericRecord(configuration, view, absoluteName); err != nil && !IsNotFoundErr(err) {
+			return err
+		}
+		return nil
+	}
+	for _, record := range records {
+		if _, err := objMgr.DeleteResourceRecord(configuration, view, record.BAMId); err != nil && !IsNotFoundErr(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterResourceRecords keeps the records of the type, dropping the ones of an unknown type
+func filterResourceRecords(records []entities.GenericRecord, typerr string) []entities.GenericRecord {
+	result := make([]entities.GenericRecord, 0, len(records))
+	for _, record := range records {
+		if strings.EqualFold(record.TypeRR, typerr) {
+			result = append(result, record)
+		}
+	}
+	return result
+}
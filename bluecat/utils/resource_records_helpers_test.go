@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+)
+
+func TestListResourceRecordsFallsBackToTheGenericRecord(t *testing.T) {
+	record := `{"id": 100345, "type": "A", "absolute_name": "api.example.com", "data": "10.0.0.1"}`
+	zone := `{"id": 100200, "absolute_name": "example.com"}`
+	// the records of the zone aren't found although the zone is: the endpoints are missing
+	requester := &scriptedRequester{responses: []string{"404 Not Found", zone, record, record, ""}}
+	objMgr := newTestObjectManager(requester)
+
+	records, byName, err := ListResourceRecords(objMgr, "Demo", "Internal", "example.com", "api.example.com", "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !byName || len(records) != 1 || records[0].BAMId != 100345 {
+		t.Fatalf("expected the Generic record of the name to be read by name, got %+v, %v", records, byName)
+	}
+	if records, _, _ := ListResourceRecords(objMgr, "Demo", "Internal", "example.com", "api.example.com", "TXT"); len(records) != 0 {
+		t.Errorf("expected no records of another type, got %+v", records)
+	}
+
+	requester.methods, requester.urls = nil, nil
+	if err := DeleteResourceRecords(objMgr, "Demo", "Internal", "api.example.com", records, byName); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := "https://bam:443/api/v1/configurations/Demo/views/Internal/generic_records/api.example.com"
+	if len(requester.urls) != 1 || requester.methods[0] != "DELETE" || !strings.HasPrefix(requester.urls[0], expected) {
+		t.Errorf("expected the record to be deleted by name with %s, got %v %v", expected, requester.methods, requester.urls)
+	}
+
+	requester.urls = nil
+	if err := DeleteResourceRecords(objMgr, "Demo", "Internal", "api.example.com", []entities.GenericRecord{}, byName); err != nil || len(requester.urls) != 0 {
+		t.Errorf("expected nothing deleted without records, got %v, %v", requester.urls, err)
+	}
+}
+
+func TestListResourceRecordsWithTheEndpoints(t *testing.T) {
+	zoneRecords := `{"resource_records": [{"id": 100201, "type": "SOA", "absolute_name": "example.com"}]}`
+	requester := &scriptedRequester{responses: []string{
+		zoneRecords,
+		"404 Not Found",
+		`{"resource_records": [{"id": 100345, "type": "A", "data": "10.0.0.1"}, {"id": 100346, "data": "10.0.0.2"}]}`,
+	}}
+	objMgr := newTestObjectManager(requester)
+
+	records, byName, err := ListResourceRecords(objMgr, "Demo", "Internal", "example.com", "api.example.com", "A")
+	if err != nil || byName || len(records) != 0 {
+		t.Fatalf("expected no records of a new name, without falling back, got %+v, %v, %v", records, byName, err)
+	}
+	records, byName, err = ListResourceRecords(objMgr, "Demo", "Internal", "example.com", "api.example.com", "A")
+	if err != nil || byName || len(records) != 1 || records[0].BAMId != 100345 {
+		t.Errorf("expected only the record of the type, got %+v, %v, %v", records, byName, err)
+	}
+	if len(requester.urls) != 3 {
+		t.Errorf("expected the endpoints to be probed once, got the requests %v", requester.urls)
+	}
+
+	requester = &scriptedRequester{responses: []string{"404 Not Found"}}
+	if _, _, err := ListResourceRecords(newTestObjectManager(requester), "Demo", "Internal", "gone.example.com", "api.gone.example.com", "A"); !IsNotFoundErr(err) {
+		t.Errorf("expected the zone not to be found, got %v", err)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// zoneFileDomainFields The positions of the domain names in the data of the record types, qualified with the origin
+var zoneFileDomainFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"MX":    {1},
+	"SRV":   {3},
+	"SOA":   {0, 1},
+	"NAPTR": {5},
+}
+
+// zoneFileClasses The classes of the zone file and whether they are supported, the records being created in the IN class
+var zoneFileClasses = map[string]bool{"IN": true, "CH": false, "HS": false, "CS": false}
+
+// zoneFileLine A logical line of the zone file, the parentheses joining the physical lines
+type zoneFileLine struct {
+	Number     int
+	Tokens     []string
+	OwnerBlank bool
+}
+
+// splitZoneFileLines Split the zone file into logical lines of tokens, dropping the comments.
+// The quoted strings are kept whole with their quotes and escapes
+func splitZoneFileLines(content string) ([]zoneFileLine, error) {
+	lines := make([]zoneFileLine, 0)
+	current := zoneFileLine{Number: 1}
+	var token strings.Builder
+	inToken, quoted, escaped, comment := false, false, false, false
+	depth, number := 0, 1
+	startOfLine := true
+
+	endToken := func() {
+		if inToken {
+			current.Tokens = append(current.Tokens, token.String())
+			token.Reset()
+			inToken = false
+		}
+	}
+	for _, r := range content {
+		if startOfLine && depth == 0 && len(current.Tokens) == 0 {
+			current.Number = number
+			current.OwnerBlank = r == ' ' || r == '\t'
+		}
+		startOfLine = false
+		switch {
+		case r == '\n':
+			if quoted {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+			}
+			escaped, comment = false, false
+			endToken()
+			number++
+			startOfLine = true
+			if depth == 0 {
+				if len(current.Tokens) > 0 {
+					lines = append(lines, current)
+				}
+				current = zoneFileLine{Number: number}
+			}
+		case comment:
+		case escaped:
+			token.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			inToken = true
+			escaped = true
+			token.WriteRune(r)
+		case r == '"':
+			inToken = true
+			quoted = !quoted
+			token.WriteRune(r)
+		case quoted:
+			token.WriteRune(r)
+		case r == ';':
+			endToken()
+			comment = true
+		case r == '(':
+			endToken()
+			depth++
+		case r == ')':
+			endToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			depth--
+		case unicode.IsSpace(r):
+			endToken()
+		default:
+			inToken = true
+			token.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.Number)
+	}
+	endToken()
+	if len(current.Tokens) > 0 {
+		lines = append(lines, current)
+	}
+	return lines, nil
+}
+
+// parseZoneFileTTL Read a TTL in seconds or with the BIND units, such as 1h30m
+func parseZoneFileTTL(value string) (int, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, ttl >= 0
+	}
+	units := map[rune]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, digits := 0, ""
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsDigit(r) {
+			digits += string(r)
+			continue
+		}
+		unit, ok := units[r]
+		if !ok || digits == "" {
+			return 0, false
+		}
+		n, _ := strconv.Atoi(digits)
+		total += n * unit
+		digits = ""
+	}
+	if digits != "" {
+		return 0, false
+	}
+	return total, true
+}
+
+// qualifyZoneFileName Get the FQDN of a name of the zone file, without the trailing dot
+func qualifyZoneFileName(name string, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		if name == "." {
+			return "."
+		}
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	}
+	return fmt.Sprintf("%s.%s", name, origin)
+}
+
+// parseZoneFile Read the records of a zone file: the $ORIGIN and $TTL directives, the relative
+// and the omitted owner names, the optional TTL and class and the records split on several lines.
+// The domain names in the data of the known types are qualified with the origin. The names of the records
+// are relative to the zone, the given origin or else the first $ORIGIN, which is returned along. An owner
+// name outside the zone is rejected
+func parseZoneFile(content string, origin string) ([]zoneRecord, string, error) {
+	lines, err := splitZoneFileLines(content)
+	if err != nil {
+		return nil, "", err
+	}
+	origin = strings.ToLower(strings.TrimSuffix(origin, "."))
+	zone := origin
+	defaultTTL := -1
+	owner := ""
+	records := make([]zoneRecord, 0)
+	numbers := make([]int, 0)
+	for _, line := range lines {
+		tokens := line.Tokens
+		if strings.HasPrefix(tokens[0], "$") {
+			directive := strings.ToUpper(tokens[0])
+			switch {
+			case directive == "$ORIGIN" && len(tokens) == 2:
+				origin = strings.ToLower(qualifyZoneFileName(tokens[1], origin))
+				if zone == "" {
+					zone = origin
+				}
+			case directive == "$TTL" && len(tokens) == 2:
+				ttl, ok := parseZoneFileTTL(tokens[1])
+				if !ok {
+					return nil, "", fmt.Errorf("line %d: invalid $TTL %q", line.Number, tokens[1])
+				}
+				defaultTTL = ttl
+			default:
+				return nil, "", fmt.Errorf("line %d: unsupported directive %s", line.Number, strings.Join(tokens, " "))
+			}
+			continue
+		}
+
+		if !line.OwnerBlank {
+			if origin == "" && !strings.HasSuffix(tokens[0], ".") {
+				return nil, "", fmt.Errorf("line %d: the relative name %q needs an $ORIGIN or the origin attribute", line.Number, tokens[0])
+			}
+			owner = strings.ToLower(qualifyZoneFileName(tokens[0], origin))
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, "", fmt.Errorf("line %d: the record has no owner name", line.Number)
+		}
+
+		ttl, ttlSet, classSet := defaultTTL, false, false
+		for len(tokens) > 0 {
+			class := strings.ToUpper(tokens[0])
+			supported, isClass := zoneFileClasses[class]
+			if value, ok := parseZoneFileTTL(tokens[0]); ok && !ttlSet {
+				ttl, ttlSet = value, true
+			} else if isClass && !classSet {
+				if !supported {
+					return nil, "", fmt.Errorf("line %d: unsupported class %s", line.Number, class)
+				}
+				classSet = true
+			} else {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 2 {
+			return nil, "", fmt.Errorf("line %d: expected a type and data", line.Number)
+		}
+		typerr := strings.ToUpper(tokens[0])
+		data := tokens[1:]
+		for _, i := range zoneFileDomainFields[typerr] {
+			if i < len(data) {
+				data[i] = strings.ToLower(qualifyZoneFileName(data[i], origin))
+			}
+		}
+		records = append(records, zoneRecord{
+			Name: owner,
+			Type: typerr,
+			Data: strings.Join(data, " "),
+			TTL:  ttl,
+		})
+		numbers = append(numbers, line.Number)
+	}
+	for i := range records {
+		if zone != "" && !inZone(records[i].Name, zone) {
+			return nil, "", fmt.Errorf("line %d: the owner name %s is outside the zone %s", numbers[i], records[i].Name, zone)
+		}
+		records[i].Name = relativeRecordName(records[i].Name, zone)
+	}
+	return records, zone, nil
+}
+
+// renderZoneFile Write the records of the zone as a zone file, the names relative to the zone
+// and the domain names in the data absolute
+func renderZoneFile(zone string, records []zoneRecord) string {
+	sorted := make([]zoneRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if (a.Name == "@") != (b.Name == "@") {
+			return a.Name == "@"
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Data < b.Data
+	})
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("$ORIGIN %s.\n", strings.TrimSuffix(zone, ".")))
+	for _, record := range sorted {
+		ttl := ""
+		if record.TTL >= 0 {
+			ttl = strconv.Itoa(record.TTL)
+		}
+		data := record.Data
+		if fields, ok := zoneFileDomainFields[record.Type]; ok {
+			if lines, err := splitZoneFileLines(data); err == nil && len(lines) == 1 {
+				tokens := lines[0].Tokens
+				for _, i := range fields {
+					if i < len(tokens) && !strings.HasSuffix(tokens[i], ".") {
+						tokens[i] += "."
+					}
+				}
+				data = strings.Join(tokens, " ")
+			}
+		}
+		content.WriteString(fmt.Sprintf("%s\t%s\tIN\t%s\t%s\n", record.Name, ttl, record.Type, data))
+	}
+	return content.String()
+}
+
+// flattenZoneFileRecords Get the records of the zone file data sources. The key of a record repeating
+// the name, the type and the data of an earlier one, such as with another TTL, ends with its occurrence
+// number, #2 for the second one
+func flattenZoneFileRecords(records []zoneRecord, zone string) []interface{} {
+	result := make([]interface{}, 0, len(records))
+	occurrences := make(map[string]int)
+	for _, record := range records {
+		key := record.identity()
+		occurrences[key]++
+		if n := occurrences[key]; n > 1 {
+			key = fmt.Sprintf("%s #%d", key, n)
+		}
+		absoluteName := record.Name
+		if zone != "" {
+			absoluteName = absoluteRecordName(record.Name, zone)
+		}
+		result = append(result, map[string]interface{}{
+			"key":           key,
+			"name":          record.Name,
+			"absolute_name": absoluteName,
+			"type":          record.Type,
+			"data":          record.Data,
+			"ttl":           record.TTL,
+		})
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+package bluecat
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+		2026101901 ; serial
+		7200       ; refresh
+		3600 1209600 300 )
+	IN	NS	ns1
+	IN	MX	10 mail
+ns1	300	IN	A	10.0.0.53
+www	IN	300	A	10.0.0.1 ; comment
+	A	10.0.0.2
+api	CNAME	www.example.com.
+txt	TXT	"v=spf1 ; -all" "second"
+$ORIGIN sub.example.com.
+host	AAAA	2001:db8::1
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, zone, err := parseZoneFile(testZoneFile, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if zone != "example.com" {
+		t.Fatalf("expected the zone example.com, got %s", zone)
+	}
+	expected := []zoneRecord{
+		{Name: "@", Type: "SOA", Data: "ns1.example.com hostmaster.example.com 2026101901 7200 3600 1209600 300", TTL: 3600},
+		{Name: "@", Type: "NS", Data: "ns1.example.com", TTL: 3600},
+		{Name: "@", Type: "MX", Data: "10 mail.example.com", TTL: 3600},
+		{Name: "ns1", Type: "A", Data: "10.0.0.53", TTL: 300},
+		{Name: "www", Type: "A", Data: "10.0.0.1", TTL: 300},
+		{Name: "www", Type: "A", Data: "10.0.0.2", TTL: 3600},
+		{Name: "api", Type: "CNAME", Data: "www.example.com", TTL: 3600},
+		{Name: "txt", Type: "TXT", Data: `"v=spf1 ; -all" "second"`, TTL: 3600},
+		{Name: "host.sub", Type: "AAAA", Data: "2001:db8::1", TTL: 3600},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Fatalf("expected %v, got %v", expected, records)
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"www A 10.0.0.1\n":                                      "needs an $ORIGIN",
+		"$ORIGIN example.com.\n\tA 10.0.0.1\n":                  "no owner name",
+		"$ORIGIN example.com.\nwww CH A 10.0.0.1\n":             "unsupported class",
+		"$ORIGIN example.com.\n@ SOA ( ns1 host\n":              "unbalanced parentheses",
+		"$INCLUDE other.zone\n":                                 "unsupported directive",
+		"$ORIGIN example.com.\nwww 300\n":                       "expected a type and data",
+		"$ORIGIN example.com.\nother.example.net. A 10.0.0.1\n": "outside the zone",
+	}
+	for content, message := range cases {
+		_, _, err := parseZoneFile(content, "")
+		if err == nil || !strings.Contains(err.Error(), message) {
+			t.Errorf("expected an error with %q for %q, got %v", message, content, err)
+		}
+	}
+	// the origin attribute stands for a missing $ORIGIN
+	records, _, err := parseZoneFile("www A 10.0.0.1\n", "example.com.")
+	if err != nil || len(records) != 1 || records[0].Name != "www" {
+		t.Fatalf("expected the record www, got %v and %v", records, err)
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	cases := map[string]int{"300": 300, "1h": 3600, "1h30m": 5400, "1W": 604800, "2d": 172800}
+	for value, expected := range cases {
+		if got, ok := parseZoneFileTTL(value); !ok || got != expected {
+			t.Errorf("expected %d for %q, got %d", expected, value, got)
+		}
+	}
+	for _, value := range []string{"", "A", "IN", "h1", "10x", "-1"} {
+		if _, ok := parseZoneFileTTL(value); ok {
+			t.Errorf("expected %q not to be a TTL", value)
+		}
+	}
+}
+
+func TestRenderZoneFileRoundTrip(t *testing.T) {
+	records := []zoneRecord{
+		{Name: "www", Type: "A", Data: "10.0.0.1", TTL: -1},
+		{Name: "@", Type: "MX", Data: "10 mail.example.com", TTL: 300},
+		{Name: "_sip._tcp", Type: "SRV", Data: "10 5 5060 sip.example.com", TTL: -1},
+		{Name: "txt", Type: "TXT", Data: `"two words" "more"`, TTL: -1},
+	}
+	content := renderZoneFile("example.com", records)
+	if !strings.HasPrefix(content, "$ORIGIN example.com.\n@\t300\tIN\tMX\t10 mail.example.com.\n") {
+		t.Fatalf("unexpected zone file:\n%s", content)
+	}
+	parsed, zone, err := parseZoneFile(content, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if zone != "example.com" || len(parsed) != len(records) {
+		t.Fatalf("expected %d records of example.com, got %v of %s", len(records), parsed, zone)
+	}
+	found := make(map[string]int)
+	for _, record := range parsed {
+		found[record.identity()] = record.TTL
+	}
+	for _, record := range records {
+		if ttl, ok := found[record.identity()]; !ok || ttl != record.TTL {
+			t.Errorf("expected the record %s with the TTL %d in\n%s", record.identity(), record.TTL, content)
+		}
+	}
+}
+
+func TestFlattenZoneFileRecordsKeysAreUnique(t *testing.T) {
+	records, zone, err := parseZoneFile("$ORIGIN example.com.\nwww 300 A 10.0.0.1\nwww 600 A 10.0.0.1\nwww A 10.0.0.2\n", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	keys := make([]string, 0)
+	for _, item := range flattenZoneFileRecords(records, zone) {
+		keys = append(keys, item.(map[string]interface{})["key"].(string))
+	}
+	expected := []string{"www A 10.0.0.1", "www A 10.0.0.1 #2", "www A 10.0.0.2"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("expected the keys %v, got %v", expected, keys)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package bluecat
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+)
+
+// zoneGlueType The pseudo type of ignore_types standing for the address records below a delegation
+const zoneGlueType = "GLUE"
+
+// zoneRecord One record of a zone, the name being relative to the zone and @ for the zone apex
+type zoneRecord struct {
+	Name string
+	Type string
+	Data string
+	TTL  int
+	ID   int
+}
+
+// key The name and the type of the record, the records with the same key forming a record set
+func (r zoneRecord) key() string {
+	return fmt.Sprintf("%s %s", r.Name, r.Type)
+}
+
+// identity The name, the type and the normalized data of the record
+func (r zoneRecord) identity() string {
+	return fmt.Sprintf("%s %s", r.key(), normalizeRecordSetValue(r.Type, r.Data))
+}
+
+// relativeRecordName Get the name of the record relative to the zone, @ for the zone apex
+func relativeRecordName(name string, zone string) string {
+	name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	switch {
+	case name == "" || name == "@" || name == zone:
+		return "@"
+	case zone != "" && strings.HasSuffix(name, "."+zone):
+		return strings.TrimSuffix(name, "."+zone)
+	}
+	return name
+}
+
+// inZone Whether or not the FQDN is the zone apex or a name below it
+func inZone(name string, zone string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	return name == zone || strings.HasSuffix(name, "."+zone)
+}
+
+// absoluteRecordName Get the FQDN of the record from its name relative to the zone
+func absoluteRecordName(name string, zone string) string {
+	if name == "@" {
+		return zone
+	}
+	return fmt.Sprintf("%s.%s", name, zone)
+}
+
+// zoneRecordsFromBAM Get the records of the zone from the resource records returned by BAM
+func zoneRecordsFromBAM(records []entities.GenericRecord, zone string) []zoneRecord {
+	result := make([]zoneRecord, 0, len(records))
+	for i := range records {
+		ttl := records[i].TTL
+		if ttl <= 0 {
+			ttl = -1
+		}
+		result = append(result, zoneRecord{
+			Name: relativeRecordName(records[i].AbsoluteName, zone),
+			Type: strings.ToUpper(records[i].TypeRR),
+			Data: genericRecordData(&records[i]),
+			TTL:  ttl,
+			ID:   records[i].BAMId,
+		})
+	}
+	return result
+}
+
+// isBelowName Whether the name is the given name or one of its subdomains
+func isBelowName(name string, parent string) bool {
+	return name == parent || strings.HasSuffix(name, "."+parent)
+}
+
+// filterIgnoredZoneRecords Drop the records of the ignored types. GLUE stands for the A and AAAA records
+// at or below a name delegated by NS records other than the ones of the zone apex
+func filterIgnoredZoneRecords(records []zoneRecord, ignoreTypes []string) []zoneRecord {
+	ignored := make(map[string]bool, len(ignoreTypes))
+	for _, typerr := range ignoreTypes {
+		ignored[strings.ToUpper(typerr)] = true
+	}
+	delegations := make([]string, 0)
+	if ignored[zoneGlueType] {
+		for _, record := range records {
+			if record.Type == "NS" && record.Name != "@" {
+				delegations = append(delegations, record.Name)
+			}
+		}
+	}
+	result := make([]zoneRecord, 0, len(records))
+	for _, record := range records {
+		if ignored[record.Type] {
+			continue
+		}
+		glue := false
+		if record.Type == "A" || record.Type == "AAAA" {
+			for _, delegation := range delegations {
+				if isBelowName(record.Name, delegation) {
+					glue = true
+					break
+				}
+			}
+		}
+		if !glue {
+			result = append(result, record)
+		}
+	}
+	return result
+}
+
+// planZoneRecords Get the records to create, update and delete so the zone holds the desired records.
+// Within a record set the records with the same data are kept, the others are updated in place when
+// possible. Only the managed records are updated or deleted, unless the zone is managed exclusively
+func planZoneRecords(desired []zoneRecord, existing []zoneRecord, managed []zoneRecord, exclusive bool) ([]zoneRecord, []zoneRecord, []zoneRecord) {
+	isManaged := make(map[string]bool, len(managed))
+	for _, record := range managed {
+		isManaged[record.identity()] = true
+	}
+	keys := make([]string, 0)
+	desiredByKey := make(map[string][]zoneRecord)
+	existingByKey := make(map[string][]zoneRecord)
+	for _, record := range desired {
+		if _, ok := desiredByKey[record.key()]; !ok {
+			keys = append(keys, record.key())
+		}
+		desiredByKey[record.key()] = append(desiredByKey[record.key()], record)
+	}
+	for _, record := range existing {
+		if _, ok := desiredByKey[record.key()]; !ok {
+			if _, ok := existingByKey[record.key()]; !ok {
+				keys = append(keys, record.key())
+			}
+		}
+		existingByKey[record.key()] = append(existingByKey[record.key()], record)
+	}
+	sort.Strings(keys)
+
+	create := make([]zoneRecord, 0)
+	update := make([]zoneRecord, 0)
+	remove := make([]zoneRecord, 0)
+	for _, key := range keys {
+		remaining := make([]zoneRecord, 0)
+		matched := make(map[int]bool)
+		for _, want := range desiredByKey[key] {
+			found := false
+			for i, have := range existingByKey[key] {
+				if !matched[i] && have.identity() == want.identity() {
+					matched[i] = true
+					found = true
+					if have.TTL != want.TTL {
+						want.ID = have.ID
+						update = append(update, want)
+					}
+					break
+				}
+			}
+			if !found {
+				remaining = append(remaining, want)
+			}
+		}
+		candidates := make([]zoneRecord, 0)
+		for i, have := range existingByKey[key] {
+			if !matched[i] && (exclusive || isManaged[have.identity()]) {
+				candidates = append(candidates, have)
+			}
+		}
+		for i, want := range remaining {
+			if i < len(candidates) {
+				want.ID = candidates[i].ID
+				update = append(update, want)
+				continue
+			}
+			create = append(create, want)
+		}
+		if len(candidates) > len(remaining) {
+			remove = append(remove, candidates[len(remaining):]...)
+		}
+	}
+	return create, update, remove
+}
+
+// readZoneRecords Get the records to keep in the state: all the records of the zone if it is managed
+// exclusively, otherwise the ones configured or already managed. The configured form of the data is kept
+// when it matches the data returned by BAM
+func readZoneRecords(existing []zoneRecord, configured []zoneRecord, managed []zoneRecord, exclusive bool) []zoneRecord {
+	known := make(map[string]zoneRecord, len(configured)+len(managed))
+	for _, record := range managed {
+		known[record.identity()] = record
+	}
+	for _, record := range configured {
+		known[record.identity()] = record
+	}
+	result := make([]zoneRecord, 0, len(existing))
+	for _, record := range existing {
+		previous, ok := known[record.identity()]
+		if !ok && !exclusive {
+			continue
+		}
+		if ok {
+			record.Data = previous.Data
+		}
+		result = append(result, record)
+	}
+	return result
+}
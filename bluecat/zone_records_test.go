@@ -0,0 +1,127 @@
+package bluecat
+
+import (
+	"reflect"
+	"sort"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRelativeRecordName(t *testing.T) {
+	cases := map[string]string{
+		"example.com":       "@",
+		"example.com.":      "@",
+		"@":                 "@",
+		"WWW.example.com.":  "www",
+		"www":               "www",
+		"a.b.example.com":   "a.b",
+		"other.example.net": "other.example.net",
+	}
+	for name, expected := range cases {
+		if got := relativeRecordName(name, "example.com"); got != expected {
+			t.Errorf("expected %q for %q, got %q", expected, name, got)
+		}
+	}
+	if absoluteRecordName("@", "example.com") != "example.com" || absoluteRecordName("www", "example.com") != "www.example.com" {
+		t.Fatal("expected the FQDN of the relative names")
+	}
+}
+
+func TestFilterIgnoredZoneRecords(t *testing.T) {
+	records := zoneRecordsFromBAM([]entities.GenericRecord{
+		{BAMId: 1, TypeRR: "SOA", AbsoluteName: "example.com", Data: "ns1.example.com. admin.example.com. 1 3600 600 86400 300"},
+		{BAMId: 2, TypeRR: "NS", AbsoluteName: "example.com", Data: "ns1.example.com."},
+		{BAMId: 3, TypeRR: "NS", AbsoluteName: "sub.example.com", Data: "ns.sub.example.com."},
+		{BAMId: 4, TypeRR: "A", AbsoluteName: "ns.sub.example.com", Data: "10.0.0.53"},
+		{BAMId: 5, TypeRR: "A", AbsoluteName: "www.example.com", Data: "10.0.0.1"},
+	}, "example.com")
+	got := make([]int, 0)
+	for _, record := range filterIgnoredZoneRecords(records, []string{"soa", "NS", "GLUE"}) {
+		got = append(got, record.ID)
+	}
+	if !reflect.DeepEqual(got, []int{5}) {
+		t.Fatalf("expected only the record 5 to be kept, got %v", got)
+	}
+	got = got[:0]
+	for _, record := range filterIgnoredZoneRecords(records, []string{"SOA"}) {
+		got = append(got, record.ID)
+	}
+	if !reflect.DeepEqual(got, []int{2, 3, 4, 5}) {
+		t.Fatalf("expected the records 2 to 5 to be kept, got %v", got)
+	}
+}
+
+func TestPlanZoneRecords(t *testing.T) {
+	existing := []zoneRecord{
+		{Name: "www", Type: "A", Data: "10.0.0.1", TTL: -1, ID: 1},
+		{Name: "www", Type: "A", Data: "10.0.0.2", TTL: -1, ID: 2},
+		{Name: "mail", Type: "MX", Data: "10 mx1.example.com", TTL: 300, ID: 3},
+		{Name: "stray", Type: "TXT", Data: "left behind", TTL: -1, ID: 4},
+	}
+	desired := []zoneRecord{
+		{Name: "www", Type: "A", Data: "10.0.0.1", TTL: -1},
+		{Name: "www", Type: "A", Data: "10.0.0.3", TTL: -1},
+		{Name: "mail", Type: "MX", Data: "10 mx1.example.com.", TTL: 600},
+		{Name: "api", Type: "CNAME", Data: "www.example.com.", TTL: -1},
+	}
+
+	// exclusive: the stray record is deleted, 10.0.0.2 becomes 10.0.0.3 and the MX TTL is updated
+	create, update, remove := planZoneRecords(desired, existing, nil, true)
+	if len(create) != 1 || create[0].Name != "api" {
+		t.Fatalf("expected to create api, got %v", create)
+	}
+	updated := map[int]string{}
+	for _, record := range update {
+		updated[record.ID] = record.Data
+	}
+	if !reflect.DeepEqual(updated, map[int]string{2: "10.0.0.3", 3: "10 mx1.example.com."}) {
+		t.Fatalf("expected to update the records 2 and 3, got %v", update)
+	}
+	if len(remove) != 1 || remove[0].ID != 4 {
+		t.Fatalf("expected to delete the record 4, got %v", remove)
+	}
+
+	// not exclusive: the records never managed are left alone
+	create, update, remove = planZoneRecords(desired, existing, nil, false)
+	if len(create) != 2 || len(update) != 1 || len(remove) != 0 {
+		t.Fatalf("expected 2 creates, 1 update and no delete, got %v, %v and %v", create, update, remove)
+	}
+
+	// not exclusive: the records no longer declared are deleted
+	managed := []zoneRecord{{Name: "stray", Type: "TXT", Data: "left behind", TTL: -1}}
+	_, _, remove = planZoneRecords(desired, existing, managed, false)
+	if len(remove) != 1 || remove[0].ID != 4 {
+		t.Fatalf("expected to delete the record 4, got %v", remove)
+	}
+}
+
+func TestReadZoneRecords(t *testing.T) {
+	existing := []zoneRecord{
+		{Name: "www", Type: "A", Data: "10.0.0.1", TTL: -1, ID: 1},
+		{Name: "stray", Type: "TXT", Data: "left behind", TTL: -1, ID: 4},
+	}
+	managed := []zoneRecord{{Name: "www", Type: "A", Data: "10.0.0.1", TTL: -1}}
+	if got := readZoneRecords(existing, managed, managed, false); len(got) != 1 || got[0].ID != 1 {
+		t.Fatalf("expected only the managed record, got %v", got)
+	}
+	if got := readZoneRecords(existing, managed, managed, true); len(got) != 2 {
+		t.Fatalf("expected all the records, got %v", got)
+	}
+}
+
+func TestZoneRecordsImporterIgnoresTheApexRecords(t *testing.T) {
+	resource := ResourceZoneRecords()
+	data := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	data.SetId("Example.com.")
+	state, err := resource.Importer.State(data, nil)
+	if err != nil {
+		t.Fatalf("unexpected import error: %s", err)
+	}
+	ignoreTypes := getIgnoreTypes(state[0])
+	sort.Strings(ignoreTypes)
+	if !state[0].Get("exclusive").(bool) || !reflect.DeepEqual(ignoreTypes, []string{"NS", "SOA"}) {
+		t.Errorf("expected the exclusive import to ignore SOA and NS, got %v", ignoreTypes)
+	}
+}
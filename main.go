@@ -4,6 +4,9 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"terraform-provider-bluecat/bluecat"
@@ -23,4 +26,9 @@ func main() {
 	}
 
 	plugin.Serve(opts)
+	// Serve returns once Terraform is done with the provider
+	if pending := bluecat.ReportPendingDeployments(); pending > 0 {
+		fmt.Fprintf(os.Stderr, "%d objects queued by deferred_deployment were never deployed: add a bluecat_deployment resource depending on them\n", pending)
+		os.Exit(1)
+	}
 }
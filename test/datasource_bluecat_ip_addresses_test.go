@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIPAddresses(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIPAddressesRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bluecat_ip_addresses.static", "count", "1"),
+					resource.TestCheckResourceAttr("data.bluecat_ip_addresses.static", "addresses.0.address", "1.1.0.42"),
+					resource.TestCheckResourceAttr("data.bluecat_ip_addresses.static", "addresses.0.state", "STATIC"),
+					resource.TestCheckResourceAttr("data.bluecat_ip_addresses.static", "addresses.0.name", "inventory-node"),
+					resource.TestCheckResourceAttr("data.bluecat_ip_addresses.static", "ip_version", "ipv4"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceIPAddressesRead = fmt.Sprintf(
+	`%s
+	resource "bluecat_ip_allocation" "inventory_node" {
+		configuration = "%s"
+		name = "inventory-node"
+		network = "1.1.0.0/16"
+		ip_address = "1.1.0.42"
+		action = "MAKE_STATIC"
+		depends_on = [bluecat_ipv4network.network_test]
+	}
+
+	data "bluecat_ip_addresses" "static" {
+		configuration = "%s"
+		network = "1.1.0.0/16"
+		start_address = "1.1.0.40"
+		end_address = "1.1.0.49"
+		states = ["STATIC"]
+		page_size = 5
+		depends_on = [bluecat_ip_allocation.inventory_node]
+	}`, GetTestEnvResources(), configuration, configuration)
@@ -0,0 +1,38 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceMXRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceMXRecordRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_mx_record.%s", mxDataSource1), "zone", zone),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_mx_record.%s", mxDataSource1), "linked_record", "mail1.example.com"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_mx_record.%s", mxDataSource1), "priority", mxPriority1),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_mx_record.%s", mxDataSource1), "ttl", mxTTL1),
+				),
+			},
+		},
+	})
+}
+
+var mxDataSource1 = "test_mx_record_1"
+var testAccDataSourceMXRecordRead = fmt.Sprintf(
+	`%s
+
+	data "bluecat_mx_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = bluecat_mx_record.%s.absolute_name
+		}`, testAccresourceMXRecordCreateFullField, mxDataSource1, configuration, view, zone, mxResource1)
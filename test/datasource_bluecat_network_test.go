@@ -24,6 +24,24 @@ func TestAccDataSourceNetworkRecord(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceIPv6Network(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIPv6NetworkRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bluecat_ipv6network.by_address", "cidr", "2003:1000::/64"),
+					resource.TestCheckResourceAttr("data.bluecat_ipv6network.by_address", "prefix", "64"),
+					resource.TestCheckResourceAttr("data.bluecat_ipv6network.by_address", "ip_version", "ipv6"),
+					resource.TestCheckResourceAttr("data.bluecat_ipv6network.by_name", "cidr", "2003:1000::/64"),
+					resource.TestCheckResourceAttrSet("data.bluecat_ipv6network.by_name", "parent_block"),
+				),
+			},
+		},
+	})
+}
+
 var ipNetworkDataSource = "test_ip4network"
 var name = "network"
 var cidrNetwork = "1.1.0.0/16"
@@ -35,3 +53,17 @@ var testAccDataSourceNetworkRead = fmt.Sprintf(
 		cidr = "%s"
 		depends_on = [bluecat_ipv4network.network_test]
 		}`, GetTestEnvResources(), ipNetworkDataSource, configuration, cidrNetwork)
+
+var testAccDataSourceIPv6NetworkRead = fmt.Sprintf(
+	`%s
+	data "bluecat_ipv6network" "by_address" {
+		configuration = "%s"
+		address = "2003:1000::15"
+		depends_on = [bluecat_ipv6network.ipv6_network_test]
+	}
+
+	data "bluecat_ipv6network" "by_name" {
+		configuration = "%s"
+		name = "test_ipv6"
+		depends_on = [bluecat_ipv6network.ipv6_network_test]
+	}`, GetTestEnvResources(), configuration, configuration)
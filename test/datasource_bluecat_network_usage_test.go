@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNetworkUsage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNetworkUsageRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bluecat_network_usage.nodes", "usage.#", "2"),
+					resource.TestCheckResourceAttr("data.bluecat_network_usage.nodes", "usage.0.cidr", "1.1.0.0/16"),
+					resource.TestCheckResourceAttr("data.bluecat_network_usage.nodes", "usage.0.type", "network"),
+					resource.TestCheckResourceAttr("data.bluecat_network_usage.nodes", "usage.0.total", "65536"),
+					resource.TestCheckResourceAttrSet("data.bluecat_network_usage.nodes", "usage.0.free"),
+					resource.TestCheckResourceAttr("data.bluecat_network_usage.nodes", "usage.1.ip_version", "ipv6"),
+					resource.TestCheckResourceAttrSet("data.bluecat_network_usage.nodes", "least_utilized"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNetworkUsageRead = fmt.Sprintf(
+	`%s
+	data "bluecat_network_usage" "nodes" {
+		configuration = "%s"
+		networks = ["1.1.0.0/16", "2003:1000::/64"]
+		depends_on = [bluecat_ipv4network.network_test, bluecat_ipv6network.ipv6_network_test]
+	}`, GetTestEnvResources(), configuration)
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNextAvailable(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNextAvailableRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bluecat_next_available_ip.nodes", "candidates.#", "3"),
+					resource.TestCheckResourceAttrSet("data.bluecat_next_available_ip.nodes", "ip_address"),
+					resource.TestCheckResourceAttr("data.bluecat_next_available_ip.nodes", "ip_version", "ipv4"),
+					resource.TestCheckResourceAttr("data.bluecat_next_available_network.pods", "candidates.#", "1"),
+					resource.TestCheckResourceAttrSet("data.bluecat_next_available_network.pods", "cidr"),
+					resource.TestCheckResourceAttr("data.bluecat_next_available_network.pods", "ip_version", "ipv6"),
+					resource.TestCheckResourceAttr("data.bluecat_next_available_block.sites", "candidates.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNextAvailableRead = fmt.Sprintf(
+	`%s
+	data "bluecat_next_available_ip" "nodes" {
+		configuration = "%s"
+		network = "1.1.0.0/16"
+		limit = 3
+		exclude = ["1.1.0.2"]
+		depends_on = [bluecat_ipv4network.network_test]
+	}
+
+	data "bluecat_next_available_network" "pods" {
+		configuration = "%s"
+		parent_block = "2003::/3"
+		size = "64"
+		depends_on = [bluecat_ipv6block.ipv6_block_test]
+	}
+
+	data "bluecat_next_available_block" "sites" {
+		configuration = "%s"
+		parent_block = "2003::/3"
+		size = "48"
+		limit = 2
+		depends_on = [bluecat_ipv6block.ipv6_block_test]
+	}`, GetTestEnvResources(), configuration, configuration, configuration)
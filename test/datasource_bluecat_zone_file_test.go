@@ -0,0 +1,68 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceZoneFile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceZoneFileRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_zone_file.%s", zoneFileDataSource1), "origin", zone),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_zone_file.%s", zoneFileDataSource1), "records.#", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_zone_file.%s", zoneFileDataSource1), "records.0.name", "www"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_zone_file.%s", zoneFileDataSource1), "records.1.data", fmt.Sprintf("www.%s", zone)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceZoneExport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceZoneExportRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.bluecat_zone_export.%s", zoneExportDataSource1), "records.#", "3"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.bluecat_zone_export.%s", zoneExportDataSource1), "content"),
+				),
+			},
+		},
+	})
+}
+
+var zoneFileDataSource1 = "test_zone_file_1"
+var testAccDataSourceZoneFileRead = fmt.Sprintf(
+	`%s
+
+	data "bluecat_zone_file" "%s" {
+		content = <<-EOT
+		$ORIGIN %s.
+		$TTL 1h
+		@ IN SOA ns1 hostmaster ( 1 7200 3600 1209600 300 )
+		www 300 IN A 1.1.0.10
+		api IN CNAME www
+		EOT
+		ignore_types = ["SOA"]
+		}`, server, zoneFileDataSource1, zone)
+
+var zoneExportDataSource1 = "test_zone_export_1"
+var testAccDataSourceZoneExportRead = fmt.Sprintf(
+	`%s
+
+	data "bluecat_zone_export" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = bluecat_zone_records.%s.zone
+		ignore_types = ["SOA", "NS"]
+		}`, testAccresourceZoneRecordsCreate, zoneExportDataSource1, configuration, view, zoneRecordsResource1)
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDeploymentOption(t *testing.T) {
+	// the test environment has no server: the scoped option is refused before reaching BAM
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccresourceDeploymentOptionUnknownServer,
+				ExpectError: regexp.MustCompile("Getting the server unknown.example.com failed"),
+			},
+			resource.TestStep{
+				Config:      testAccresourceDeploymentOptionDHCPOnZone,
+				ExpectError: regexp.MustCompile("DHCP deployment options can only be put on networks, blocks and IP addresses"),
+			},
+		},
+	})
+}
+
+var testAccresourceDeploymentOptionUnknownServer = fmt.Sprintf(
+	`%s
+	resource "bluecat_deployment_option" "unknown_server" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		name = "allow-query"
+		value = "any"
+		server_fqdn = "unknown.example.com"
+		depends_on = [bluecat_zone.sub_zone_test]
+	}`, GetTestEnvResources(), configuration, view, zone)
+
+var testAccresourceDeploymentOptionDHCPOnZone = fmt.Sprintf(
+	`%s
+	resource "bluecat_deployment_option" "dhcp_on_zone" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		option_type = "dhcp_service"
+		name = "default-lease-time"
+		value = "86400"
+		depends_on = [bluecat_zone.sub_zone_test]
+	}`, GetTestEnvResources(), configuration, view, zone)
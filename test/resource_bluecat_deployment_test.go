@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDeployment(t *testing.T) {
+	// the record deployment is queued and sent by bluecat_deployment
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccresourceDeploymentDeferred,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTXTRecordExists(t, fmt.Sprintf("bluecat_txt_record.%s", deploymentTXTResource), deploymentTXTName, txtTTL1, txtText1),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_deployment.%s", deploymentResource), "deployed_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var deploymentResource = "deploy_records"
+var deploymentTXTResource = "txt_record_deferred"
+var deploymentTXTName = "deferred.example.com"
+var deferredServer = fmt.Sprintf(
+	`provider "bluecat" {
+		server = "%s"
+		api_version = "1"
+		transport = "http"
+		port = "80"
+		username = "admin"
+		password = "admin"
+		deferred_deployment = true
+	  }`, serverIP)
+var testAccresourceDeploymentDeferred = fmt.Sprintf(
+	`%s
+	%s
+	%s
+	resource "bluecat_txt_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		text = ["%s"]
+		ttl = %s
+		to_deploy = "yes"
+		depends_on = [bluecat_zone.sub_zone_test]
+	}
+	resource "bluecat_deployment" "%s" {
+		triggers = {
+			txt_record = bluecat_txt_record.%s.id
+		}
+		depends_on = [bluecat_txt_record.%s]
+	}`, deferredServer, viewTestResource, zoneTestResource, deploymentTXTResource, configuration, view, zone, deploymentTXTName, txtText1, txtTTL1,
+	deploymentResource, deploymentTXTResource, deploymentTXTResource)
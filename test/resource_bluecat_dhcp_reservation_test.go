@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"terraform-provider-bluecat/bluecat/entities"
+	"terraform-provider-bluecat/bluecat/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDHCPReservation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDHCPReservationDestroy,
+		Steps: []resource.TestStep{
+			// reserve the next available address
+			{
+				Config: testAccResourceDHCPReservationCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDHCPReservationExists("bluecat_dhcp_reservation.pxe_client", "00:11:22:aa:bb:cc"),
+					resource.TestCheckResourceAttr("bluecat_dhcp_reservation.pxe_client", "hostname", "pxe-01"),
+					resource.TestCheckResourceAttr("bluecat_dhcp_reservation.pxe_client", "boot_file", "pxelinux.0"),
+				),
+			},
+			// change the MAC address and the options in place
+			{
+				Config: testAccResourceDHCPReservationUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDHCPReservationExists("bluecat_dhcp_reservation.pxe_client", "00:11:22:aa:bb:dd"),
+					resource.TestCheckResourceAttr("bluecat_dhcp_reservation.pxe_client", "hostname", "pxe-02"),
+					resource.TestCheckResourceAttr("bluecat_dhcp_reservation.pxe_client", "boot_file", ""),
+				),
+			},
+			{
+				ResourceName:            "bluecat_dhcp_reservation.pxe_client",
+				ImportState:             true,
+				ImportStateId:           "1.1.0.0/16/00:11:22:aa:bb:dd",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"to_deploy", "batch_mode", "last_deployment_status", "properties"},
+			},
+		},
+	})
+}
+
+func testAccDHCPReservationExists(resourceName string, mac string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		objMgr := testAccObjectManager()
+		address, err := objMgr.GetIPAddress(configuration, rs.Primary.Attributes["ip_address"], entities.IPV4)
+		if err != nil {
+			return fmt.Errorf("Getting the reserved IP address %s failed: %s", rs.Primary.Attributes["ip_address"], err)
+		}
+		if state := utils.GetPropertyValue("state", address.Properties); state != "DHCP_RESERVED" {
+			return fmt.Errorf("expected the IP address %s to be DHCP_RESERVED, got %s", address.Address, state)
+		}
+		if bamMAC := utils.GetPropertyValue("macAddress", address.Properties); bamMAC != mac {
+			return fmt.Errorf("expected the IP address %s to be reserved for %s, got %s", address.Address, mac, bamMAC)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDHCPReservationDestroy(s *terraform.State) error {
+	objMgr := testAccObjectManager()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bluecat_dhcp_reservation" {
+			continue
+		}
+		if _, err := objMgr.GetIPAddress(configuration, rs.Primary.Attributes["ip_address"], entities.IPV4); err == nil {
+			return fmt.Errorf("the IP address %s is still reserved", rs.Primary.Attributes["ip_address"])
+		}
+	}
+	return nil
+}
+
+var testAccResourceDHCPReservationCreate = fmt.Sprintf(
+	`%s
+	resource "bluecat_dhcp_reservation" "pxe_client" {
+		configuration = "%s"
+		network = "1.1.0.0/16"
+		mac_address = "00-11-22-AA-BB-CC"
+		name = "pxe-client"
+		hostname = "pxe-01"
+		next_server = "1.1.0.5"
+		boot_file = "pxelinux.0"
+		depends_on = [bluecat_ipv4network.network_test]
+	}`, GetTestEnvResources(), configuration)
+
+var testAccResourceDHCPReservationUpdate = fmt.Sprintf(
+	`%s
+	resource "bluecat_dhcp_reservation" "pxe_client" {
+		configuration = "%s"
+		network = "1.1.0.0/16"
+		mac_address = "00:11:22:aa:bb:dd"
+		name = "pxe-client"
+		hostname = "pxe-02"
+		next_server = "1.1.0.5"
+		depends_on = [bluecat_ipv4network.network_test]
+	}`, GetTestEnvResources(), configuration)
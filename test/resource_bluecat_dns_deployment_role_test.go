@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDNSDeploymentRole(t *testing.T) {
+	// the test environment has no server: the role is refused before reaching BAM
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccresourceDNSDeploymentRoleUnknownServer,
+				ExpectError: regexp.MustCompile("Server 'unknown.example.com' doesn't exists"),
+			},
+			resource.TestStep{
+				Config:      testAccresourceDNSDeploymentRoleDHCPOnZone,
+				ExpectError: regexp.MustCompile("DHCP deployment roles can only be put on networks and blocks"),
+			},
+		},
+	})
+}
+
+var testAccresourceDNSDeploymentRoleUnknownServer = fmt.Sprintf(
+	`%s
+	resource "bluecat_dns_deployment_role" "unknown_server" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		server_fqdn = "unknown.example.com"
+		role = "secondary"
+		depends_on = [bluecat_zone.sub_zone_test]
+	}`, GetTestEnvResources(), configuration, view, zone)
+
+var testAccresourceDNSDeploymentRoleDHCPOnZone = fmt.Sprintf(
+	`%s
+	resource "bluecat_dns_deployment_role" "dhcp_on_zone" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		server_fqdn = "unknown.example.com"
+		role_type = "dhcp"
+		role = "primary"
+		depends_on = [bluecat_zone.sub_zone_test]
+	}`, GetTestEnvResources(), configuration, view, zone)
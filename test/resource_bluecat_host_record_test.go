@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"net"
+	"strings"
 	"terraform-provider-bluecat/bluecat/entities"
 	"terraform-provider-bluecat/bluecat/utils"
 	"testing"
@@ -48,6 +50,72 @@ func TestAccResourceHostRecord(t *testing.T) {
 	})
 }
 
+func TestAccResourceHostRecordDualStack(t *testing.T) {
+	// create with IPv4 and IPv6 addresses, then change the addresses and the reverse records
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckHostRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccresourceHostRecordDualStackCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccHostRecordAddresses(fmt.Sprintf("bluecat_host_record.%s", hostResourceDual), hostNameDual, "1.1.0.20", "2003:1000::20"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_host_record.%s", hostResourceDual), "addresses.#", "2"),
+				),
+			},
+			// the addresses are a set, the order and the form of the IPv6 address must not produce a diff
+			{
+				Config:   testAccresourceHostRecordDualStackReordered,
+				PlanOnly: true,
+			},
+			{
+				Config: testAccresourceHostRecordDualStackUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccHostRecordAddresses(fmt.Sprintf("bluecat_host_record.%s", hostResourceDual), hostNameDual, "1.1.0.21", "2003:1000::20", "2003:1000::21"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_host_record.%s", hostResourceDual), "addresses.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostRecordAddresses(resource string, name string, addresses ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[resource]; !ok {
+			return fmt.Errorf("Not found %s", resource)
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(*utils.Connector)
+		objMgr := new(utils.ObjectManager)
+		objMgr.Connector = connector
+		hostRecord, err := objMgr.GetHostRecord(configuration, view, name)
+		if err != nil {
+			return fmt.Errorf("Getting Host record %s failed: %s", name, err)
+		}
+		remote := utils.GetPropertyValue("addresses", hostRecord.Properties)
+		if !sameAddresses(strings.Split(remote, ","), addresses) {
+			return fmt.Errorf("Getting Host record %s failed. Expect addresses=%s, but received '%s'", name, strings.Join(addresses, ","), remote)
+		}
+		return nil
+	}
+}
+
+func sameAddresses(remote []string, expected []string) bool {
+	if len(remote) != len(expected) {
+		return false
+	}
+	found := make(map[string]bool)
+	for _, address := range remote {
+		found[net.ParseIP(strings.TrimSpace(address)).String()] = true
+	}
+	for _, address := range expected {
+		if !found[net.ParseIP(address).String()] {
+			return false
+		}
+	}
+	return true
+}
+
 func testAccCheckHostRecordDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 	connector := meta.(*utils.Connector)
@@ -155,3 +223,58 @@ var testAccresourceHostRecordUpdateFullField = fmt.Sprintf(
 		properties = "%s"
 		depends_on = [bluecat_zone.sub_zone_test, bluecat_ipv4network.network_test]
 		}`, GetTestEnvResources(), hostResource1, configuration, view, zone, hostName1, hostIP2, hostTTL2, hostProperties2)
+
+var hostResourceDual = "host_record_dual"
+var hostNameDual = "dual.example.com"
+var testAccresourceHostRecordDualStackCreate = fmt.Sprintf(
+	`%s
+	resource "bluecat_host_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		addresses {
+			address = "1.1.0.20"
+		}
+		addresses {
+			address = "2003:1000::20"
+			reverse_record = false
+		}
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_ipv4network.network_test, bluecat_ipv6network.ipv6_network_test]
+		}`, GetTestEnvResources(), hostResourceDual, configuration, view, zone, hostNameDual)
+
+var testAccresourceHostRecordDualStackReordered = fmt.Sprintf(
+	`%s
+	resource "bluecat_host_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		addresses {
+			address = "2003:1000:0::20"
+			reverse_record = false
+		}
+		addresses {
+			address = "1.1.0.20"
+		}
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_ipv4network.network_test, bluecat_ipv6network.ipv6_network_test]
+		}`, GetTestEnvResources(), hostResourceDual, configuration, view, zone, hostNameDual)
+
+var testAccresourceHostRecordDualStackUpdate = fmt.Sprintf(
+	`%s
+	resource "bluecat_host_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		addresses {
+			address = "1.1.0.21"
+		}
+		addresses {
+			address = "2003:1000::20"
+		}
+		addresses {
+			address = "2003:1000::21"
+		}
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_ipv4network.network_test, bluecat_ipv6network.ipv6_network_test]
+		}`, GetTestEnvResources(), hostResourceDual, configuration, view, zone, hostNameDual)
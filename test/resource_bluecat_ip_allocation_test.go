@@ -191,6 +191,53 @@ func TestAccResourceIPAllocation(t *testing.T) {
 	})
 }
 
+func TestAccResourceIPAllocationStateTransitions(t *testing.T) {
+	// move the same address between the states in place: the Host record follows, the address is never released
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIPAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccresourceIPAllocationWithAction(entities.AllocateReserved),
+				Check:  testAccIPAllocationState(ipAllocateIP7, ipAllocateName7, "RESERVED", false),
+			},
+			{
+				Config: testAccresourceIPAllocationWithAction(entities.AllocateStatic),
+				Check:  testAccIPAllocationState(ipAllocateIP7, ipAllocateName7, "STATIC", true),
+			},
+			{
+				Config: testAccresourceIPAllocationWithAction(entities.AllocateDHCPReserved),
+				Check:  testAccIPAllocationState(ipAllocateIP7, ipAllocateName7, "DHCP_RESERVED", true),
+			},
+			{
+				Config: testAccresourceIPAllocationWithAction(entities.AllocateReserved),
+				Check:  testAccIPAllocationState(ipAllocateIP7, ipAllocateName7, "RESERVED", false),
+			},
+		},
+	})
+}
+
+func testAccIPAllocationState(ip string, name string, state string, hasHostRecord bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		objMgr := testAccObjectManager()
+		ipAddress, err := objMgr.GetIPAddress(configuration, ip, entities.IPV4)
+		if err != nil {
+			return fmt.Errorf("Getting ip %s failed: %s", ip, err)
+		}
+		if current := utils.GetPropertyValue("state", ipAddress.Properties); current != state {
+			return fmt.Errorf("Expect the IP %s to be %s, but it is %s", ip, state, current)
+		}
+		_, err = objMgr.GetHostRecord(configuration, view, name)
+		if hasHostRecord && err != nil {
+			return fmt.Errorf("Getting Host record %s of the %s IP %s failed: %s", name, state, ip, err)
+		}
+		if !hasHostRecord && err == nil {
+			return fmt.Errorf("Host record %s of the %s IP %s is not removed", name, state, ip)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIPAllocationDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 	connector := meta.(*utils.Connector)
@@ -487,3 +534,22 @@ var testAccResourceIPv6AllocationCreateNotIP = fmt.Sprintf(
 		ip_version = "ipv6"
 		depends_on = [bluecat_ipv6network.ipv6_network_test, bluecat_zone.sub_zone_test]
 		}`, GetTestEnvResources(), configuration, view, zone)
+
+var ipAllocateIP7 = "1.1.0.41"
+var ipAllocateName7 = "allocation7.example.com"
+
+func testAccresourceIPAllocationWithAction(action string) string {
+	return fmt.Sprintf(
+		`%s
+	resource "bluecat_ip_allocation" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		name = "%s"
+		network = "%s"
+		ip_address = "%s"
+		mac_address = "%s"
+		action = "%s"
+		depends_on = [bluecat_ipv4network.network_test, bluecat_zone.sub_zone_test]
+		}`, GetTestEnvResources(), ipAllocateResource1, configuration, view, zone, ipAllocateName7, ipAllocateNet1, ipAllocateIP7, ipAllocateMac1, action)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"terraform-provider-bluecat/bluecat/entities"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceIPRangeAllocation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIPRangeAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccresourceIPRangeAllocation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bluecat_ip_range_allocation.vip_pool", "addresses.#", "4"),
+					testAccIPRangeAllocationConsecutive("bluecat_ip_range_allocation.vip_pool", 4),
+				),
+			},
+		},
+	})
+}
+
+func testAccIPRangeAllocationConsecutive(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		objMgr := testAccObjectManager()
+		var previous net.IP
+		for i := 0; i < count; i++ {
+			address := rs.Primary.Attributes["addresses."+strconv.Itoa(i)]
+			ip := net.ParseIP(address).To4()
+			if ip == nil {
+				return fmt.Errorf("invalid address %q in the run", address)
+			}
+			if previous != nil && ip[3] != previous[3]+1 {
+				return fmt.Errorf("the address %s doesn't follow %s", ip, previous)
+			}
+			if _, err := objMgr.GetIPAddress(configuration, address, entities.IPV4); err != nil {
+				return fmt.Errorf("Getting IP address %s failed: %s", address, err)
+			}
+			previous = ip
+		}
+		return nil
+	}
+}
+
+func testAccCheckIPRangeAllocationDestroy(s *terraform.State) error {
+	objMgr := testAccObjectManager()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bluecat_ip_range_allocation" {
+			continue
+		}
+		for key, address := range rs.Primary.Attributes {
+			if key == "addresses.#" || !strings.HasPrefix(key, "addresses.") {
+				continue
+			}
+			if _, err := objMgr.GetIPAddress(configuration, address, entities.IPV4); err == nil {
+				return fmt.Errorf("the IP address %s is still allocated", address)
+			}
+		}
+	}
+	return nil
+}
+
+var testAccresourceIPRangeAllocation = fmt.Sprintf(
+	`%s
+	resource "bluecat_ip_range_allocation" "vip_pool" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		network = "1.1.0.0/16"
+		address_count = 4
+		name_template = "vip-%%d"
+		depends_on = [bluecat_ipv4network.network_test, bluecat_zone.sub_zone_test]
+	}`, GetTestEnvResources(), configuration, view, zone)
@@ -0,0 +1,179 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package main
+
+import (
+	"fmt"
+	"terraform-provider-bluecat/bluecat/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceMXRecord(t *testing.T) {
+	// create with full fields and update
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckMXRecordDestroy,
+		Steps: []resource.TestStep{
+			// create
+			resource.TestStep{
+				Config: testAccresourceMXRecordCreateFullField,
+				Check: resource.ComposeTestCheckFunc(
+					testAccMXRecordExists(t, fmt.Sprintf("bluecat_mx_record.%s", mxResource1), mxName1, mxPriority1),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_mx_record.%s", mxResource1), "priority", mxPriority1),
+				),
+			},
+			// the trailing dot on linked_record must not produce a diff
+			resource.TestStep{
+				Config:   testAccresourceMXRecordCreateTrailingDot,
+				PlanOnly: true,
+			},
+			// update
+			resource.TestStep{
+				Config: testAccresourceMXRecordUpdateFullField,
+				Check: resource.ComposeTestCheckFunc(
+					testAccMXRecordExists(t, fmt.Sprintf("bluecat_mx_record.%s", mxResource1), mxName1, mxPriority2),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_mx_record.%s", mxResource1), "priority", mxPriority2),
+				),
+			},
+		},
+	})
+	// create without some optional fields
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckMXRecordDestroy,
+		Steps: []resource.TestStep{
+			// create
+			resource.TestStep{
+				Config: testAccresourceMXRecordCreateNotFullField,
+				Check: resource.ComposeTestCheckFunc(
+					testAccMXRecordExists(t, fmt.Sprintf("bluecat_mx_record.%s", mxResource1), mxName1, mxPriority1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMXRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+	connector := meta.(*utils.Connector)
+	objMgr := new(utils.ObjectManager)
+	objMgr.Connector = connector
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "bluecat_mx_record" {
+			_, err := objMgr.GetMXRecord(configuration, view, rs.Primary.ID)
+			if err == nil {
+				msg := fmt.Sprintf("MX record %s is not removed", rs.Primary.ID)
+				log.Error(msg)
+				return fmt.Errorf(msg)
+			}
+		} else {
+			msg := fmt.Sprintf("There is an unexpected resource %s %s", rs.Primary.ID, rs.Type)
+			log.Error(msg)
+		}
+	}
+	return nil
+}
+
+func testAccMXRecordExists(t *testing.T, resource string, name string, priority string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+		// check MX record on BAM
+		meta := testAccProvider.Meta()
+		connector := meta.(*utils.Connector)
+		objMgr := new(utils.ObjectManager)
+		objMgr.Connector = connector
+		mxRecord, err := objMgr.GetMXRecord(configuration, view, name)
+		if err != nil {
+			msg := fmt.Sprintf("Getting MX record %s failed: %s", rs.Primary.ID, err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		priorityProperty := utils.GetPropertyValue("priority", mxRecord.Properties)
+		if priorityProperty != priority {
+			msg := fmt.Sprintf("Getting MX record %s failed. Expect priority=%s in properties, but received '%s'", rs.Primary.ID, priority, mxRecord.Properties)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		return nil
+	}
+}
+
+var testAccresourceHostMXRecordCreate = fmt.Sprintf(
+	`%s
+	resource "bluecat_host_record" "mx_host_record" {
+		configuration = "%s"
+		view = "%s"
+		absolute_name = "mail1.example.com"
+		ip_address = "1.1.0.3"
+		ttl = 200
+		properties = ""
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_ipv4network.network_test]
+		}`, GetTestEnvResources(), configuration, view)
+
+var mxResource1 = "mx_record_office"
+var mxName1 = "office.example.com"
+var mxPriority1 = "10"
+var mxTTL1 = "400"
+var testAccresourceMXRecordCreateFullField = fmt.Sprintf(
+	`%s
+	resource "bluecat_mx_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		linked_record = "mail1.example.com"
+		priority = %s
+		ttl = %s
+		properties = ""
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_host_record.mx_host_record]
+	  }`, testAccresourceHostMXRecordCreate, mxResource1, configuration, view, zone, mxName1, mxPriority1, mxTTL1)
+
+var testAccresourceMXRecordCreateTrailingDot = fmt.Sprintf(
+	`%s
+	resource "bluecat_mx_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		linked_record = "mail1.example.com."
+		priority = %s
+		ttl = %s
+		properties = ""
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_host_record.mx_host_record]
+	  }`, testAccresourceHostMXRecordCreate, mxResource1, configuration, view, zone, mxName1, mxPriority1, mxTTL1)
+
+var testAccresourceMXRecordCreateNotFullField = fmt.Sprintf(
+	`%s
+	resource "bluecat_mx_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		absolute_name = "%s"
+		linked_record = "mail1.example.com"
+		priority = %s
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_host_record.mx_host_record]
+		}`, testAccresourceHostMXRecordCreate, mxResource1, configuration, view, mxName1, mxPriority1)
+
+var mxPriority2 = "20"
+var mxTTL2 = "4000"
+var testAccresourceMXRecordUpdateFullField = fmt.Sprintf(
+	`%s
+	resource "bluecat_mx_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		linked_record = "mail1.example.com"
+		priority = %s
+		ttl = %s
+		properties = ""
+		depends_on = [bluecat_zone.sub_zone_test, bluecat_host_record.mx_host_record]
+		}`, testAccresourceHostMXRecordCreate, mxResource1, configuration, view, zone, mxName1, mxPriority2, mxTTL2)
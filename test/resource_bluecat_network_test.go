@@ -149,6 +149,42 @@ func TestAccResourceNetwork(t *testing.T) {
 			},
 		},
 	})
+	// create the next available IPv6 /64, applying again finds it by its allocated id instead of adding another one
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNextAvailableIPv6Network,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("bluecat_ipv6network.next_ipv6_net", "cidr"),
+					resource.TestCheckResourceAttr("bluecat_ipv6network.next_ipv6_net", "ip_version", entities.IPV6),
+					testAccNextAvailableIPv6NetworkAllocated("bluecat_ipv6network.next_ipv6_net", "2040:B042::/48", "next_ipv6_net"),
+				),
+			},
+			{
+				Config:   testAccResourceNextAvailableIPv6Network,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccNextAvailableIPv6NetworkAllocated(resourceName string, parentBlock string, allocatedId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found %s", resourceName)
+		}
+		network, err := testAccObjectManager().GetNetworkByAllocatedId(configuration, parentBlock, allocatedId, entities.IPV6)
+		if err != nil {
+			return fmt.Errorf("Getting the IPv6 Network by the allocated id %s failed: %s", allocatedId, err)
+		}
+		if !strings.EqualFold(network.CIDR, rs.Primary.Attributes["cidr"]) {
+			return fmt.Errorf("Expect the allocated id %s on %s, but it is on %s", allocatedId, rs.Primary.Attributes["cidr"], network.CIDR)
+		}
+		return nil
+	}
 }
 
 func testAccCheckNetworkDestroy(s *terraform.State) error {
@@ -501,3 +537,25 @@ var testAccResourceNextAvailableNetworkBurst = fmt.Sprintf(
 		properties = ""
 		depends_on = [bluecat_ipv4block.next_net_burst_parent]
 	}`, server, configuration, configuration, configuration, configuration, configuration, configuration)
+
+var testAccResourceNextAvailableIPv6Network = fmt.Sprintf(
+	`%s
+	resource "bluecat_ipv6block" "next_ipv6_net_parent" {
+		configuration = "%s"
+		name = "next_ipv6_net_parent"
+		address = "2040:B042::"
+		cidr = "48"
+		properties = ""
+		ip_version = "ipv6"
+	}
+
+	resource "bluecat_ipv6network" "next_ipv6_net" {
+		configuration = "%s"
+		name = "next_ipv6_net"
+		parent_block = "2040:B042::/48"
+		size = "64"
+		allocated_id = "next_ipv6_net"
+		ip_version = "ipv6"
+		properties = ""
+		depends_on = [bluecat_ipv6block.next_ipv6_net_parent]
+	}`, GetTestEnvResources(), configuration, configuration)
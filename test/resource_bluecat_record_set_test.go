@@ -0,0 +1,118 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package main
+
+import (
+	"fmt"
+	"terraform-provider-bluecat/bluecat/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceRecordSet(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckRecordSetDestroy,
+		Steps: []resource.TestStep{
+			// create
+			resource.TestStep{
+				Config: testAccresourceRecordSetCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordSetCount(t, recordSetName1, "MX", 2),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_record_set.%s", recordSetResource1), "values.#", "2"),
+				),
+			},
+			// a record created out of band is reported as drift
+			resource.TestStep{
+				PreConfig: func() {
+					objMgr := testAccObjectManager()
+					_, err := objMgr.CreateGenericRecord(configuration, view, zone, "MX", recordSetName1, "30 extra.example.com", -1, "")
+					if err != nil {
+						t.Fatalf("Creating the extra MX record failed: %s", err)
+					}
+				},
+				Config:             testAccresourceRecordSetCreate,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// and deleted on the next apply, along with the value removed from the set
+			resource.TestStep{
+				Config: testAccresourceRecordSetUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordSetCount(t, recordSetName1, "MX", 1),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_record_set.%s", recordSetResource1), "values.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectManager() *utils.ObjectManager {
+	meta := testAccProvider.Meta()
+	connector := meta.(*utils.Connector)
+	objMgr := new(utils.ObjectManager)
+	objMgr.Connector = connector
+	return objMgr
+}
+
+func testAccCheckRecordSetDestroy(s *terraform.State) error {
+	objMgr := testAccObjectManager()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bluecat_record_set" {
+			msg := fmt.Sprintf("There is an unexpected resource %s %s", rs.Primary.ID, rs.Type)
+			log.Error(msg)
+			continue
+		}
+		records, err := objMgr.GetResourceRecords(configuration, view, zone, rs.Primary.Attributes["name"], rs.Primary.Attributes["type"])
+		if err == nil && len(records.Records) > 0 {
+			msg := fmt.Sprintf("Record set %s is not removed", rs.Primary.ID)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+	}
+	return nil
+}
+
+func testAccRecordSetCount(t *testing.T, name string, typerr string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		records, err := testAccObjectManager().GetResourceRecords(configuration, view, zone, name, typerr)
+		if err != nil {
+			msg := fmt.Sprintf("Getting %s record set %s failed: %s", typerr, name, err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		if len(records.Records) != count {
+			return fmt.Errorf("Expected %d %s records for %s, got %d", count, typerr, name, len(records.Records))
+		}
+		return nil
+	}
+}
+
+var recordSetResource1 = "record_set_1"
+var recordSetName1 = "mail.example.com"
+var testAccresourceRecordSetCreate = fmt.Sprintf(
+	`%s
+	resource "bluecat_record_set" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		name = "%s"
+		type = "MX"
+		values = ["10 mx1.example.com.", "20 mx2.example.com"]
+		depends_on = [bluecat_zone.sub_zone_test]
+	  }`, GetTestEnvResources(), recordSetResource1, configuration, view, zone, recordSetName1)
+
+var testAccresourceRecordSetUpdate = fmt.Sprintf(
+	`%s
+	resource "bluecat_record_set" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		name = "%s"
+		type = "MX"
+		values = ["10 mx1.example.com."]
+		ttl = 300
+		depends_on = [bluecat_zone.sub_zone_test]
+	  }`, GetTestEnvResources(), recordSetResource1, configuration, view, zone, recordSetName1)
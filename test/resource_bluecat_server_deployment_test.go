@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceServerDeployment(t *testing.T) {
+	// a server which doesn't exist in the configuration fails the deployment
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccresourceServerDeploymentUnknownServer,
+				ExpectError: regexp.MustCompile("getting server unknown.example.com failed"),
+			},
+		},
+	})
+}
+
+var testAccresourceServerDeploymentUnknownServer = fmt.Sprintf(
+	`%s
+	resource "bluecat_server_deployment" "unknown_server" {
+		configuration = "%s"
+		servers = ["unknown.example.com"]
+		deployment_type = "quick"
+		service = "DNS"
+	}`, server, configuration)
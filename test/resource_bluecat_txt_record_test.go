@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"terraform-provider-bluecat/bluecat/utils"
 	"testing"
 
@@ -47,6 +48,39 @@ func TestAccResourceTXTRecord(t *testing.T) {
 	})
 }
 
+func TestAccResourceTXTRecordMultiString(t *testing.T) {
+	// a DKIM key longer than 255 bytes is split, and read back without drift
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckTXTRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccresourceTXTRecordLongText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_txt_record.%s", txtResourceDKIM), "text.#", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_txt_record.%s", txtResourceDKIM), "text.0", txtTextDKIM),
+				),
+			},
+			{
+				Config:   testAccresourceTXTRecordLongText,
+				PlanOnly: true,
+			},
+			// several strings, each one kept as its own character-string
+			{
+				Config: testAccresourceTXTRecordMultiString,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_txt_record.%s", txtResourceDKIM), "text.#", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_txt_record.%s", txtResourceDKIM), "text.1", `say "hello"`),
+				),
+			},
+			{
+				Config:   testAccresourceTXTRecordMultiString,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckTXTRecordDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
 	connector := meta.(*utils.Connector)
@@ -112,7 +146,7 @@ var testAccresourceTXTRecordCreateFullField = fmt.Sprintf(
 		view = "%s"
 		zone = "%s"
 		absolute_name = "%s"
-		text = "%s"
+		text = ["%s"]
 		ttl = %s
 		properties = "%s"
 		depends_on = [bluecat_zone.sub_zone_test]
@@ -124,7 +158,7 @@ var testAccresourceTXTRecordCreateNotFullField = fmt.Sprintf(
 		configuration = "%s"
 		view = "%s"
 		absolute_name = "%s"
-		text = "%s"
+		text = ["%s"]
 		ttl = %s
 		properties = "%s"
 		depends_on = [bluecat_zone.sub_zone_test]
@@ -140,8 +174,34 @@ var testAccresourceTXTRecordUpdateFullField = fmt.Sprintf(
 		view = "%s"
 		zone = "%s"
 		absolute_name = "%s"
-		text = "%s"
+		text = ["%s"]
 		ttl = %s
 		properties = "%s"
 		depends_on = [bluecat_zone.sub_zone_test]
 		}`, GetTestEnvResources(), txtResource1, configuration, view, zone, txtName1, txtText2, txtTTL2, txtProperties2)
+
+var txtResourceDKIM = "txt_record_dkim"
+var txtNameDKIM = "selector1._domainkey.example.com"
+var txtTextDKIM = "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA", 8)
+var testAccresourceTXTRecordLongText = fmt.Sprintf(
+	`%s
+	resource "bluecat_txt_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		text = ["%s"]
+		auto_split = true
+		depends_on = [bluecat_zone.sub_zone_test]
+		}`, GetTestEnvResources(), txtResourceDKIM, configuration, view, zone, txtNameDKIM, txtTextDKIM)
+
+var testAccresourceTXTRecordMultiString = fmt.Sprintf(
+	`%s
+	resource "bluecat_txt_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		text = ["v=spf1 include:_spf.example.com ~all", "say \"hello\""]
+		depends_on = [bluecat_zone.sub_zone_test]
+		}`, GetTestEnvResources(), txtResourceDKIM, configuration, view, zone, txtNameDKIM)
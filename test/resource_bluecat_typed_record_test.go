@@ -0,0 +1,159 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-bluecat/bluecat/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceCAARecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckTypedRecordDestroy,
+		Steps: []resource.TestStep{
+			// create
+			resource.TestStep{
+				Config: testAccresourceCAARecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTypedRecordExists(t, fmt.Sprintf("bluecat_caa_record.%s", caaResource1), "CAA", caaName1),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_caa_record.%s", caaResource1), "data", `0 issue "letsencrypt.org"`),
+				),
+			},
+			// the case of the tag must not produce a diff
+			resource.TestStep{
+				Config:   strings.Replace(testAccresourceCAARecordCreate, `tag = "issue"`, `tag = "ISSUE"`, 1),
+				PlanOnly: true,
+			},
+			// update
+			resource.TestStep{
+				Config: testAccresourceCAARecordUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTypedRecordExists(t, fmt.Sprintf("bluecat_caa_record.%s", caaResource1), "CAA", caaName1),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_caa_record.%s", caaResource1), "flags", "128"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_caa_record.%s", caaResource1), "tag", "issuewild"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSSHFPRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckTypedRecordDestroy,
+		Steps: []resource.TestStep{
+			// create with the fingerprint in upper case, read back in lower case without a diff
+			resource.TestStep{
+				Config: testAccresourceSSHFPRecordCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTypedRecordExists(t, fmt.Sprintf("bluecat_sshfp_record.%s", sshfpResource1), "SSHFP", sshfpName1),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_sshfp_record.%s", sshfpResource1), "fingerprint", strings.ToLower(sshfpFingerprint1)),
+				),
+			},
+			resource.TestStep{
+				Config:   testAccresourceSSHFPRecordCreate,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTypedRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+	connector := meta.(*utils.Connector)
+	objMgr := new(utils.ObjectManager)
+	objMgr.Connector = connector
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "bluecat_naptr_record", "bluecat_caa_record", "bluecat_sshfp_record", "bluecat_tlsa_record":
+			_, err := objMgr.GetGenericRecord(configuration, view, rs.Primary.ID)
+			if err == nil {
+				msg := fmt.Sprintf("Record %s is not removed", rs.Primary.ID)
+				log.Error(msg)
+				return fmt.Errorf(msg)
+			}
+		default:
+			msg := fmt.Sprintf("There is an unexpected resource %s %s", rs.Primary.ID, rs.Type)
+			log.Error(msg)
+		}
+	}
+	return nil
+}
+
+func testAccTypedRecordExists(t *testing.T, resource string, typerr string, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(*utils.Connector)
+		objMgr := new(utils.ObjectManager)
+		objMgr.Connector = connector
+		record, err := objMgr.GetGenericRecord(configuration, view, name)
+		if err != nil {
+			msg := fmt.Sprintf("Getting %s record %s failed: %s", typerr, rs.Primary.ID, err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		if record.TypeRR != "" && !strings.EqualFold(record.TypeRR, typerr) {
+			msg := fmt.Sprintf("Getting %s record %s failed: the record type is %s", typerr, rs.Primary.ID, record.TypeRR)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		return nil
+	}
+}
+
+var caaResource1 = "caa_record_1"
+var caaName1 = "caa.example.com"
+var testAccresourceCAARecordCreate = fmt.Sprintf(
+	`%s
+	resource "bluecat_caa_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		tag = "issue"
+		value = "letsencrypt.org"
+		depends_on = [bluecat_zone.sub_zone_test]
+	  }`, GetTestEnvResources(), caaResource1, configuration, view, zone, caaName1)
+
+var testAccresourceCAARecordUpdate = fmt.Sprintf(
+	`%s
+	resource "bluecat_caa_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		flags = 128
+		tag = "issuewild"
+		value = "ca.example.net"
+		ttl = 300
+		depends_on = [bluecat_zone.sub_zone_test]
+	  }`, GetTestEnvResources(), caaResource1, configuration, view, zone, caaName1)
+
+var sshfpResource1 = "sshfp_record_1"
+var sshfpName1 = "sshfp.example.com"
+var sshfpFingerprint1 = "8F3C0C4ED6A1E3B9C0E45F6A0B1D2C3E4F5A6B7C8D9E0F1A2B3C4D5E6F7A8B9C"
+var testAccresourceSSHFPRecordCreate = fmt.Sprintf(
+	`%s
+	resource "bluecat_sshfp_record" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		absolute_name = "%s"
+		algorithm = 4
+		fingerprint_type = 2
+		fingerprint = "%s"
+		depends_on = [bluecat_zone.sub_zone_test]
+	  }`, GetTestEnvResources(), sshfpResource1, configuration, view, zone, sshfpName1, sshfpFingerprint1)
@@ -0,0 +1,146 @@
+// Copyright 2026 BlueCat Networks. All rights reserved
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceZoneRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			// create
+			resource.TestStep{
+				Config: testAccresourceZoneRecordsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneRecordsCount(t, 3),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_zone_records.%s", zoneRecordsResource1), "record.#", "3"),
+				),
+			},
+			// a stray record shows as drift when the zone is managed exclusively
+			resource.TestStep{
+				PreConfig: func() {
+					_, err := testAccObjectManager().CreateGenericRecord(configuration, view, zone, "TXT", fmt.Sprintf("stray.%s", zone), "left behind", -1, "")
+					if err != nil {
+						t.Fatalf("Creating the stray TXT record failed: %s", err)
+					}
+				},
+				Config:             testAccresourceZoneRecordsCreate,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// and is deleted on the next apply, while the updated record is changed in place
+			resource.TestStep{
+				Config: testAccresourceZoneRecordsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneRecordsCount(t, 3),
+					resource.TestCheckResourceAttr(fmt.Sprintf("bluecat_zone_records.%s", zoneRecordsResource1), "record.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckZoneRecordsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bluecat_zone_records" {
+			msg := fmt.Sprintf("There is an unexpected resource %s %s", rs.Primary.ID, rs.Type)
+			log.Error(msg)
+			continue
+		}
+		records, err := testAccObjectManager().GetZoneResourceRecords(configuration, view, zone)
+		if err != nil {
+			continue
+		}
+		for _, record := range records.Records {
+			if record.TypeRR != "SOA" && record.TypeRR != "NS" {
+				msg := fmt.Sprintf("Record %s %s of the zone %s is not removed", record.TypeRR, record.AbsoluteName, rs.Primary.ID)
+				log.Error(msg)
+				return fmt.Errorf(msg)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccZoneRecordsCount(t *testing.T, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		records, err := testAccObjectManager().GetZoneResourceRecords(configuration, view, zone)
+		if err != nil {
+			msg := fmt.Sprintf("Getting the records of the zone %s failed: %s", zone, err)
+			log.Error(msg)
+			return fmt.Errorf(msg)
+		}
+		found := 0
+		for _, record := range records.Records {
+			if record.TypeRR != "SOA" && record.TypeRR != "NS" {
+				found++
+			}
+		}
+		if found != count {
+			return fmt.Errorf("Expected %d records in the zone %s, got %d", count, zone, found)
+		}
+		return nil
+	}
+}
+
+var zoneRecordsResource1 = "zone_records_1"
+var testAccresourceZoneRecordsCreate = fmt.Sprintf(
+	`%s
+	resource "bluecat_zone_records" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		exclusive = true
+		ignore_types = ["SOA", "NS", "GLUE"]
+		record {
+			name = "www"
+			type = "A"
+			data = "1.1.0.10"
+		}
+		record {
+			name = "www"
+			type = "A"
+			data = "1.1.0.11"
+		}
+		record {
+			name = "@"
+			type = "MX"
+			data = "10 mail.%s."
+			ttl = 300
+		}
+		depends_on = [bluecat_zone.sub_zone_test]
+	  }`, GetTestEnvResources(), zoneRecordsResource1, configuration, view, zone, zone)
+
+var testAccresourceZoneRecordsUpdate = fmt.Sprintf(
+	`%s
+	resource "bluecat_zone_records" "%s" {
+		configuration = "%s"
+		view = "%s"
+		zone = "%s"
+		exclusive = true
+		ignore_types = ["SOA", "NS", "GLUE"]
+		record {
+			name = "www"
+			type = "A"
+			data = "1.1.0.10"
+		}
+		record {
+			name = "www"
+			type = "A"
+			data = "1.1.0.12"
+		}
+		record {
+			name = "@"
+			type = "MX"
+			data = "20 mail.%s."
+			ttl = 300
+		}
+		depends_on = [bluecat_zone.sub_zone_test]
+	  }`, GetTestEnvResources(), zoneRecordsResource1, configuration, view, zone, zone)